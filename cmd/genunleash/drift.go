@@ -0,0 +1,71 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/drift"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/generator"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+const (
+	formatText = "text"
+	formatJSON = "json"
+)
+
+// errDrift is returned when the configuration drifted from Unleash.
+var errDrift = errors.New("drift detected")
+
+func runDrift(name string, args []string, stdout io.Writer, stderr io.Writer) error {
+	var projectIDs stringsFlag
+	var format string
+	var concurrency int
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Var(&projectIDs, "project", "also report features which are only in Unleash for the project (repeatable or comma separated)")
+	flags.StringVar(&format, "format", formatText, "\"text\" or \"json\"")
+	flags.IntVar(&concurrency, "concurrency", unleash.DefaultFetchConcurrency, "number of features fetched concurrently")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintf(flags.Output(), "usage: %s [flags] <config_dir>\n\nflags:\n", name)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return usageError{err: err}
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return usageError{err: errors.New("exactly one configuration directory must be given")}
+	}
+	if format != formatText && format != formatJSON {
+		return usageError{err: fmt.Errorf("invalid format %q, expected %q or %q", format, formatText, formatJSON)}
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return err
+	}
+	report, err := drift.Detect(client, flags.Arg(0), projectIDs, generator.WithConcurrency(concurrency))
+	if err != nil {
+		return err
+	}
+
+	if format == formatJSON {
+		err = report.WriteJSON(stdout)
+	} else {
+		err = report.WriteText(stdout)
+	}
+	if err != nil {
+		return err
+	}
+	if report.HasDrift() {
+		return errDrift
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/generator"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+type generateFlags struct {
+	allProjects      bool
+	outDir           string
+	filePrefix       string
+	fileSuffix       string
+	includedFeatures stringsFlag
+	excludedFeatures stringsFlag
+	featureTypes     stringsFlag
+	archived         bool
+	segments         bool
+	dryRun           bool
+	concurrency      int
+	layout           string
+	groupBy          string
+	segmentsByName   bool
+}
+
+const (
+	// layoutFiles generates all resources of a project in a single file.
+	layoutFiles = "files"
+	// layoutModule generates a Terraform module with a file per feature.
+	layoutModule = "module"
+)
+
+type generatedFile struct {
+	name    string
+	content []byte
+}
+
+func runGenerate(name string, args []string, stdout io.Writer, stderr io.Writer) error {
+	startTs := time.Now()
+
+	var f generateFlags
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.BoolVar(&f.allProjects, "all-projects", false, "generate all projects returned by Unleash")
+	flags.StringVar(&f.outDir, "out-dir", ".", "directory where the files are written")
+	flags.StringVar(&f.filePrefix, "file-prefix", "gen", "prefix of the generated file names")
+	flags.StringVar(&f.fileSuffix, "file-suffix", ".out.tf", "suffix of the generated file names")
+	flags.Var(&f.includedFeatures, "include", "only generate features whose name matches the glob pattern (repeatable or comma separated)")
+	flags.Var(&f.excludedFeatures, "exclude", "skip features whose name matches the glob pattern (repeatable or comma separated)")
+	flags.Var(&f.featureTypes, "types", "only generate features of the types, e.g. release,experiment")
+	flags.BoolVar(&f.archived, "include-archived", false, "also generate archived features, which are revived when applied")
+	flags.BoolVar(&f.segments, "segments", true, "generate segments")
+	flags.BoolVar(&f.segmentsByName, "import-segments-by-name", false, "import segments by <project>/<name> or name:<name> rather than ID")
+	flags.BoolVar(&f.dryRun, "dry-run", false, "write the generated files to stdout instead of files")
+	flags.IntVar(&f.concurrency, "concurrency", unleash.DefaultFetchConcurrency, "number of features fetched concurrently")
+	flags.StringVar(&f.layout, "layout", layoutFiles, "\"files\" to generate a file per project or \"module\" to generate a Terraform module with a file per feature")
+	flags.StringVar(&f.groupBy, "group-by", "", "group features into child modules by \"type\", \"project\" or \"tag:<tag_type>\" with -layout=module")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintf(flags.Output(), "usage: %s [flags] <project_id>...\n       %s [flags] -all-projects\n\nflags:\n", name, name)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return usageError{err: err}
+	}
+	projectIDs := flags.Args()
+	if f.allProjects == (len(projectIDs) > 0) {
+		flags.Usage()
+		return usageError{err: errors.New("either -all-projects or project IDs must be given")}
+	}
+	if f.layout != layoutFiles && f.layout != layoutModule {
+		return usageError{err: fmt.Errorf("invalid layout %q, expected %q or %q", f.layout, layoutFiles, layoutModule)}
+	}
+	if f.groupBy != "" && f.layout != layoutModule {
+		return usageError{err: errors.New("-group-by requires -layout=module")}
+	}
+
+	client, err := createClient()
+	if err != nil {
+		return err
+	}
+
+	opts := []generator.Option{
+		generator.WithIncludedFeatures(f.includedFeatures...),
+		generator.WithExcludedFeatures(f.excludedFeatures...),
+		generator.WithFeatureTypes(f.featureTypes...),
+		generator.WithConcurrency(f.concurrency),
+	}
+	if f.archived {
+		opts = append(opts, generator.WithArchivedFeatures())
+	}
+	if !f.segments {
+		opts = append(opts, generator.WithoutSegments())
+	}
+	if f.segmentsByName {
+		opts = append(opts, generator.WithSegmentImportByName())
+	}
+
+	var files []generatedFile
+	switch {
+	case f.layout == layoutModule:
+		files, err = generateModule(client, projectIDs, append(opts, generator.WithGroupBy(f.groupBy)))
+	case len(projectIDs) == 1:
+		files, err = generateProject(client, projectIDs[0], f, opts)
+	default:
+		files, err = generateProjects(client, projectIDs, f, opts)
+	}
+	if err != nil {
+		return err
+	}
+
+	if f.dryRun {
+		return printFiles(files, stdout)
+	}
+	if err := writeFiles(files, f.outDir); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(stdout, "Successfully generate %d files in %s in %v seconds\n", len(files), f.outDir, time.Since(startTs).Seconds())
+
+	return nil
+}
+
+// generateProject generates a single project in <prefix><suffix> and <prefix>-import<suffix> files.
+func generateProject(client unleash.ClientWithResponsesInterface, projectID string, f generateFlags, opts []generator.Option) ([]generatedFile, error) {
+	tf := &bytes.Buffer{}
+	importTf := &bytes.Buffer{}
+	err := generator.Generate(client, projectID, tf, importTf, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return []generatedFile{
+		{name: f.filePrefix + f.fileSuffix, content: tf.Bytes()},
+		{name: f.filePrefix + "-import" + f.fileSuffix, content: importTf.Bytes()},
+	}, nil
+}
+
+// generateProjects generates each project in <prefix>.<project_id><suffix> and <prefix>-import.<project_id><suffix> files
+// while the resources shared by all projects are generated in <prefix><suffix> and <prefix>-import<suffix> files.
+func generateProjects(client unleash.ClientWithResponsesInterface, projectIDs []string, f generateFlags, opts []generator.Option) ([]generatedFile, error) {
+	generated, err := generator.GenerateProjects(client, projectIDs, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]generatedFile, 0, 2*len(generated))
+	for _, g := range generated {
+		suffix := f.fileSuffix
+		if g.ProjectID != "" {
+			suffix = "." + g.ProjectID + suffix
+		}
+		files = append(files,
+			generatedFile{name: f.filePrefix + suffix, content: g.Tf},
+			generatedFile{name: f.filePrefix + "-import" + suffix, content: g.ImportTf},
+		)
+	}
+
+	return files, nil
+}
+
+// generateModule generates a Terraform module where each feature is in its own file.
+func generateModule(client unleash.ClientWithResponsesInterface, projectIDs []string, opts []generator.Option) ([]generatedFile, error) {
+	generated, err := generator.GenerateModule(client, projectIDs, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]generatedFile, 0, len(generated))
+	for _, g := range generated {
+		files = append(files, generatedFile{name: g.Path, content: g.Content})
+	}
+
+	return files, nil
+}
+
+func writeFiles(files []generatedFile, dir string) error {
+	for _, file := range files {
+		filePath := filepath.Join(dir, filepath.FromSlash(file.name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+			return fmt.Errorf("failed to create directory of %s: %w", file.name, err)
+		}
+		if err := os.WriteFile(filePath, file.content, 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", file.name, err)
+		}
+	}
+
+	return nil
+}
+
+func printFiles(files []generatedFile, w io.Writer) error {
+	for _, file := range files {
+		if _, err := fmt.Fprintf(w, "# %s\n%s\n", file.name, file.content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,14 +1,16 @@
 package main
 
 import (
-	"bufio"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"os"
-	"time"
+	"sort"
+	"strings"
 
 	"github.com/kelseyhightower/envconfig"
 
-	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/generator"
 	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
 )
 
@@ -17,69 +19,110 @@ type Config struct {
 	AuthorizationToken string `envconfig:"UNLEASH_AUTHORIZATION_TOKEN" required:"true"`
 }
 
-func main() {
-	cfg := Config{}
-	envconfig.MustProcess("APP", &cfg)
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+	exitDrift = 3
+)
 
-	err := run(cfg, os.Args)
-	if err != nil {
-		panic(err)
-	}
+// command runs a subcommand with its arguments excluding the subcommand name.
+type command struct {
+	description string
+	run         func(name string, args []string, stdout io.Writer, stderr io.Writer) error
 }
 
-func run(cfg Config, args []string) error {
-	startTs := time.Now()
+var commands = map[string]command{
+	"drift": {
+		description: "report features in Unleash which differ from the Terraform configuration",
+		run:         runDrift,
+	},
+	"generate": {
+		description: "generate Terraform resources and import blocks from Unleash",
+		run:         runGenerate,
+	},
+}
 
-	if len(args) != 2 {
-		return fmt.Errorf("usage: %s <project_id>", args[0])
-	}
+// usageError is returned when the command line is invalid.
+type usageError struct {
+	err error
+}
 
-	client, err := unleash.CreateClient(cfg.BaseURL, cfg.AuthorizationToken)
-	if err != nil {
-		return err
-	}
+func (e usageError) Error() string {
+	return e.err.Error()
+}
 
-	tfWriter, cleanFn1, err := createWriter("gen.out.tf")
-	defer cleanFn1()
-	if err != nil {
-		return err
-	}
+func main() {
+	os.Exit(run(os.Args, os.Stdout, os.Stderr))
+}
 
-	importWriter, cleanFn2, err := createWriter("gen-import.out.tf")
-	defer cleanFn2()
-	if err != nil {
-		return err
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	// generate is the default command to keep `genunleash <project_id>` working
+	name, cmdArgs := "generate", args[1:]
+	if len(cmdArgs) > 0 {
+		if cmd, ok := commands[cmdArgs[0]]; ok {
+			return exitCode(cmd.run(args[0]+" "+cmdArgs[0], cmdArgs[1:], stdout, stderr), stderr)
+		}
+		if cmdArgs[0] == "help" || cmdArgs[0] == "-h" || cmdArgs[0] == "-help" || cmdArgs[0] == "--help" {
+			printUsage(args[0], stdout)
+			return exitOK
+		}
 	}
 
-	err = generator.Generate(client, args[1], tfWriter, importWriter)
-	if err != nil {
-		return err
+	return exitCode(commands[name].run(args[0], cmdArgs, stdout, stderr), stderr)
+}
+
+func exitCode(err error, stderr io.Writer) int {
+	var usageErr usageError
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return exitOK
+	case errors.Is(err, errDrift):
+		return exitDrift
+	case errors.As(err, &usageErr):
+		_, _ = fmt.Fprintf(stderr, "error: %s\n", err)
+		return exitUsage
+	default:
+		_, _ = fmt.Fprintf(stderr, "error: %s\n", err)
+		return exitError
 	}
+}
 
-	err = tfWriter.Flush()
-	if err != nil {
-		return err
+func printUsage(name string, w io.Writer) {
+	_, _ = fmt.Fprintf(w, "usage: %s <command> [flags] [args]\n\ncommands:\n", name)
+	cmdNames := make([]string, 0, len(commands))
+	for cmdName := range commands {
+		cmdNames = append(cmdNames, cmdName)
 	}
+	sort.Strings(cmdNames)
+	for _, cmdName := range cmdNames {
+		_, _ = fmt.Fprintf(w, "  %-10s %s\n", cmdName, commands[cmdName].description)
+	}
+	_, _ = fmt.Fprintf(w, "\nRun '%s <command> -h' for the flags of a command.\n", name)
+}
 
-	err = importWriter.Flush()
-	if err != nil {
-		return err
+func createClient() (unleash.ClientWithResponsesInterface, error) {
+	cfg := Config{}
+	if err := envconfig.Process("APP", &cfg); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
 	}
 
-	fmt.Printf("Successfully generate gen.out.tf and gen-import.out.tf files in %v seconds\n", time.Since(startTs).Seconds())
+	return unleash.CreateClient(cfg.BaseURL, cfg.AuthorizationToken)
+}
 
-	return nil
+// stringsFlag collects the values of a repeated flag. Each value may also be a comma separated list.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
 }
 
-func createWriter(fileName string) (*bufio.Writer, func(), error) {
-	fo, err := os.Create(fileName)
-	if err != nil {
-		return nil, func() {}, err
-	}
-	cleanUpFn := func() {
-		if err := fo.Close(); err != nil {
-			panic(err)
+func (f *stringsFlag) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*f = append(*f, v)
 		}
 	}
-	return bufio.NewWriter(fo), cleanUpFn, nil
+
+	return nil
 }
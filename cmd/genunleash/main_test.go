@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestRun(t *testing.T) {
+	server := inmem.CreateTestServer()
+	port := server.Start(t)
+
+	// the feature is not in Unleash so it drifts
+	configDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "main.tf"), []byte(`resource "unleash_feature" "a" {
+  project = "default"
+  name    = "feature.a"
+  type    = "release"
+}`), 0o644))
+
+	var testCases = []struct {
+		name           string
+		args           []string
+		withoutConfig  bool
+		fault          *inmem.Fault
+		expectedCode   int
+		expectedStdout string
+		expectedStderr string
+	}{
+		{
+			name:           "help",
+			args:           []string{"-h"},
+			expectedCode:   exitOK,
+			expectedStdout: "usage: genunleash <command> [flags] [args]",
+		},
+		{
+			name:           "command help",
+			args:           []string{"drift", "-h"},
+			expectedCode:   exitOK,
+			expectedStderr: "usage: genunleash drift [flags] <config_dir>",
+		},
+		{
+			name:           "usage error",
+			args:           []string{"drift"},
+			expectedCode:   exitUsage,
+			expectedStderr: "error: exactly one configuration directory must be given",
+		},
+		{
+			name:           "invalid flag",
+			args:           []string{"generate", "-unknown"},
+			expectedCode:   exitUsage,
+			expectedStderr: "error: flag provided but not defined: -unknown",
+		},
+		{
+			name:           "config error",
+			args:           []string{"drift", configDir},
+			withoutConfig:  true,
+			expectedCode:   exitError,
+			expectedStderr: "error: invalid configuration",
+		},
+		{
+			name:           "missing config dir",
+			args:           []string{"drift", filepath.Join(configDir, "missing")},
+			expectedCode:   exitError,
+			expectedStderr: "error: ",
+		},
+		{
+			name:           "request error",
+			args:           []string{"drift", configDir},
+			fault:          &inmem.Fault{PathPrefix: "/api/admin/segments", StatusCode: 401, Times: 1},
+			expectedCode:   exitError,
+			expectedStderr: "error: failed to get segments",
+		},
+		{
+			name:           "drift",
+			args:           []string{"drift", configDir},
+			expectedCode:   exitDrift,
+			expectedStdout: "- default/feature.a",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			for _, key := range []string{"UNLEASH_BASE_URL", "UNLEASH_AUTHORIZATION_TOKEN", "APP_UNLEASH_BASE_URL", "APP_UNLEASH_AUTHORIZATION_TOKEN"} {
+				tt.Setenv(key, "")
+				require.NoError(tt, os.Unsetenv(key))
+			}
+			if !testCase.withoutConfig {
+				tt.Setenv("UNLEASH_BASE_URL", "http://localhost:"+strconv.Itoa(port))
+				tt.Setenv("UNLEASH_AUTHORIZATION_TOKEN", "any")
+			}
+			if testCase.fault != nil {
+				server.InjectFault(*testCase.fault)
+			}
+			stdout := &bytes.Buffer{}
+			stderr := &bytes.Buffer{}
+
+			code := run(append([]string{"genunleash"}, testCase.args...), stdout, stderr)
+
+			assert.Equal(tt, testCase.expectedCode, code, stderr.String())
+			assert.Contains(tt, stdout.String(), testCase.expectedStdout)
+			assert.Contains(tt, stderr.String(), testCase.expectedStderr)
+		})
+	}
+}
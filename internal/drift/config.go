@@ -0,0 +1,225 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+// featureAttributes are the attributes of unleash_feature which are compared.
+// Stale is not compared since it is not generated.
+var featureAttributes = []string{"type", "description", "impression_data", "environments", "dependencies", "tags"}
+
+type featureKey struct {
+	project string
+	name    string
+}
+
+type feature struct {
+	address    string
+	filename   string
+	attributes map[string]cty.Value
+}
+
+type segmentKey struct {
+	project string
+	name    string
+}
+
+func getSegmentIDs(client unleash.ClientWithResponsesInterface) (map[segmentKey]int, error) {
+	segmentsResp, err := client.GetSegmentsWithResponse(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	if segmentsResp.StatusCode() > 299 {
+		return nil, fmt.Errorf("failed to get segments: %d %s", segmentsResp.StatusCode(), string(segmentsResp.Body))
+	}
+	segmentIDs := make(map[segmentKey]int)
+	if segmentsResp.JSON200.Segments == nil {
+		return segmentIDs, nil
+	}
+	for _, segment := range *segmentsResp.JSON200.Segments {
+		key := segmentKey{name: segment.Name}
+		if segment.Project != nil {
+			key.project = *segment.Project
+		}
+		segmentIDs[key] = segment.Id
+	}
+
+	return segmentIDs, nil
+}
+
+// loadConfig loads unleash_feature resources of the .tf files in dir and its subdirectories, which are usually child modules.
+// Hidden directories such as .terraform are skipped.
+func loadConfig(dir string, segmentIDs map[segmentKey]int) (map[featureKey]feature, error) {
+	filesByDir := make(map[string][]*hclsyntax.Body)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != dir && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".tf" {
+			return nil
+		}
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		filename, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		file, diags := hclsyntax.ParseConfig(src, filepath.ToSlash(filename), hcl.InitialPos)
+		if diags.HasErrors() {
+			return diags
+		}
+		filesByDir[filepath.Dir(path)] = append(filesByDir[filepath.Dir(path)], file.Body.(*hclsyntax.Body))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	features := make(map[featureKey]feature)
+	for _, bodies := range filesByDir {
+		// segments are only referenced within the same module
+		evalCtx, err := createEvalContext(bodies, segmentIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, body := range bodies {
+			err := loadFeatures(body, evalCtx, features)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return features, nil
+}
+
+// createEvalContext resolves `unleash_segment.<name>.id` and `unleash_segment.<name>.id_int` references to the IDs of the
+// segments in Unleash. References to segments which do not exist in Unleash are unknown.
+func createEvalContext(bodies []*hclsyntax.Body, segmentIDs map[segmentKey]int) (*hcl.EvalContext, error) {
+	segments := make(map[string]cty.Value)
+	for _, body := range bodies {
+		for _, block := range body.Blocks {
+			if block.Type != "resource" || len(block.Labels) != 2 || block.Labels[0] != "unleash_segment" {
+				continue
+			}
+			attributes, err := evaluate(block, nil)
+			if err != nil {
+				return nil, err
+			}
+			name, _ := toString(attributes["name"])
+			project, _ := toString(attributes["project"])
+			id, ok := segmentIDs[segmentKey{project: project, name: name}]
+			if !ok {
+				segments[block.Labels[1]] = cty.ObjectVal(map[string]cty.Value{
+					"id":     cty.UnknownVal(cty.String),
+					"id_int": cty.UnknownVal(cty.Number),
+				})
+				continue
+			}
+			segments[block.Labels[1]] = cty.ObjectVal(map[string]cty.Value{
+				"id":     cty.StringVal(strconv.Itoa(id)),
+				"id_int": cty.NumberIntVal(int64(id)),
+			})
+		}
+	}
+
+	return &hcl.EvalContext{
+		Variables: map[string]cty.Value{
+			"unleash_segment": cty.ObjectVal(segments),
+		},
+	}, nil
+}
+
+func loadFeatures(body *hclsyntax.Body, evalCtx *hcl.EvalContext, features map[featureKey]feature) error {
+	for _, block := range body.Blocks {
+		if block.Type != "resource" || len(block.Labels) != 2 || block.Labels[0] != "unleash_feature" {
+			continue
+		}
+		attributes, err := evaluate(block, evalCtx)
+		if err != nil {
+			return err
+		}
+		address := "unleash_feature." + block.Labels[1]
+		key, err := toFeatureKey(address, attributes)
+		if err != nil {
+			return err
+		}
+		filename := block.DefRange().Filename
+		if existing, ok := features[key]; ok {
+			return fmt.Errorf("feature %s of project %s is defined by both %s in %s and %s in %s",
+				key.name, key.project, existing.address, existing.filename, address, filename)
+		}
+		features[key] = feature{
+			address:    address,
+			filename:   filename,
+			attributes: attributes,
+		}
+	}
+
+	return nil
+}
+
+// parseFeatures loads unleash_feature resources of a single file.
+func parseFeatures(filename string, src []byte, evalCtx *hcl.EvalContext, features map[featureKey]feature) error {
+	file, diags := hclsyntax.ParseConfig(src, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return diags
+	}
+
+	return loadFeatures(file.Body.(*hclsyntax.Body), evalCtx, features)
+}
+
+func evaluate(block *hclsyntax.Block, evalCtx *hcl.EvalContext) (map[string]cty.Value, error) {
+	attributes := make(map[string]cty.Value, len(block.Body.Attributes))
+	for name, attribute := range block.Body.Attributes {
+		value, diags := attribute.Expr.Value(evalCtx)
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("failed to evaluate %s of %s.%s, only literals and unleash_segment references are supported: %w",
+				name, block.Labels[0], block.Labels[1], diags)
+		}
+		attributes[name] = value
+	}
+
+	return attributes, nil
+}
+
+func toFeatureKey(address string, attributes map[string]cty.Value) (featureKey, error) {
+	project, ok := toString(attributes["project"])
+	if !ok {
+		return featureKey{}, fmt.Errorf("project of %s must be a string", address)
+	}
+	name, ok := toString(attributes["name"])
+	if !ok {
+		return featureKey{}, fmt.Errorf("name of %s must be a string", address)
+	}
+
+	return featureKey{project: project, name: name}, nil
+}
+
+func toString(value cty.Value) (string, bool) {
+	if value.Type() != cty.String || value.IsNull() || !value.IsKnown() {
+		return "", false
+	}
+
+	return value.AsString(), true
+}
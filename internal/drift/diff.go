@@ -0,0 +1,312 @@
+package drift
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// mapAttributes are object attributes whose keys are elements, e.g. environment names, rather than attributes.
+var mapAttributes = map[string]bool{
+	"environments": true,
+	"parameters":   true,
+}
+
+// elementKeys are the attributes which identify elements of lists and sets by attribute name.
+// Elements of other lists, e.g. strategies, are matched by their index.
+var elementKeys = map[string][]string{
+	"variants":     {"name"},
+	"overrides":    {"context_name"},
+	"tags":         {"type", "value"},
+	"dependencies": {"feature"},
+	"segments":     nil,
+}
+
+// unmanagedWhenNullAttributes are the attributes which unleash_feature leaves as they are in Unleash if they are not specified.
+var unmanagedWhenNullAttributes = map[string]bool{
+	"dependencies": true,
+	"tags":         true,
+}
+
+func diffFeature(configFeature feature, liveFeature feature) []Change {
+	var changes []Change
+	for _, name := range featureAttributes {
+		configValue, ok := configFeature.attributes[name]
+		if unmanagedWhenNullAttributes[name] && (!ok || configValue.IsNull()) {
+			continue
+		}
+		liveValue := liveFeature.attributes[name]
+		if name == "environments" {
+			liveValue = withoutUnmanagedAttributes(configFeature.attributes[name], liveValue)
+		}
+		diffValue(name, name, configFeature.attributes[name], liveValue, &changes)
+	}
+
+	return changes
+}
+
+// unmanagedAttributes maps the manage_* attributes of environments to the attributes which are managed by other resources
+// when they are false in the configuration.
+var unmanagedAttributes = map[string]string{
+	"manage_enabled":    "enabled",
+	"manage_strategies": "strategies",
+}
+
+// withoutUnmanagedAttributes removes the live attributes of environments which are not managed by unleash_feature,
+// e.g. strategies when manage_strategies is false.
+func withoutUnmanagedAttributes(configEnvironments cty.Value, liveEnvironments cty.Value) cty.Value {
+	if configEnvironments == cty.NilVal || liveEnvironments == cty.NilVal || !isObject(configEnvironments) || !isObject(liveEnvironments) {
+		return liveEnvironments
+	}
+	liveAttributes := attributesOf(liveEnvironments)
+	if len(liveAttributes) == 0 {
+		return liveEnvironments
+	}
+	for name, configEnvironment := range attributesOf(configEnvironments) {
+		liveEnvironment, ok := liveAttributes[name]
+		if !ok || !isObject(configEnvironment) || !isObject(liveEnvironment) || liveEnvironment.IsNull() {
+			continue
+		}
+		configAttributes := attributesOf(configEnvironment)
+		environmentAttributes := attributesOf(liveEnvironment)
+		for manageName, attributeName := range unmanagedAttributes {
+			manage, ok := configAttributes[manageName]
+			if !ok || manage.Type() != cty.Bool || manage.IsNull() || !manage.IsKnown() || manage.True() {
+				continue
+			}
+			delete(environmentAttributes, attributeName)
+		}
+		liveAttributes[name] = cty.ObjectVal(environmentAttributes)
+	}
+
+	return cty.ObjectVal(liveAttributes)
+}
+
+// diffValue appends the changes between the values of an attribute. Null, false, zero, empty strings
+// and empty collections are the same since Unleash does not distinguish them.
+func diffValue(path string, name string, configValue cty.Value, liveValue cty.Value, changes *[]Change) {
+	normalizedConfig := normalize(configValue)
+	normalizedLive := normalize(liveValue)
+	if normalizedConfig.IsNull() && normalizedLive.IsNull() {
+		return
+	}
+
+	switch {
+	case isObject(normalizedConfig) && isObject(normalizedLive):
+		diffObject(path, name, normalizedConfig, normalizedLive, changes)
+	case isList(normalizedConfig) && isList(normalizedLive):
+		diffList(path, name, normalizedConfig, normalizedLive, changes)
+	case !equals(name, normalizedConfig, normalizedLive):
+		// report the values as they are, e.g. false rather than null
+		*changes = append(*changes, newChange(path, Changed, configValue, liveValue))
+	}
+}
+
+func diffObject(path string, name string, configValue cty.Value, liveValue cty.Value, changes *[]Change) {
+	configAttributes := attributesOf(configValue)
+	liveAttributes := attributesOf(liveValue)
+	keys := make([]string, 0, len(configAttributes)+len(liveAttributes))
+	for key := range configAttributes {
+		keys = append(keys, key)
+	}
+	for key := range liveAttributes {
+		if _, ok := configAttributes[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		configElement := configAttributes[key]
+		liveElement := liveAttributes[key]
+		switch {
+		case !mapAttributes[name]:
+			diffValue(path+"."+key, key, configElement, liveElement, changes)
+		case normalize(configElement).IsNull() && !normalize(liveElement).IsNull():
+			*changes = append(*changes, newChange(path+"."+key, Added, cty.NilVal, liveElement))
+		case !normalize(configElement).IsNull() && normalize(liveElement).IsNull():
+			*changes = append(*changes, newChange(path+"."+key, Removed, configElement, cty.NilVal))
+		default:
+			// elements of a map have no attribute name
+			diffValue(path+"."+key, "", configElement, liveElement, changes)
+		}
+	}
+}
+
+func diffList(path string, name string, configValue cty.Value, liveValue cty.Value, changes *[]Change) {
+	configElements := elementsOf(configValue)
+	liveElements := elementsOf(liveValue)
+	attributeNames, keyed := elementKeys[name]
+	configKeys, configKeyed := keysOf(configElements, attributeNames)
+	liveKeys, liveKeyed := keysOf(liveElements, attributeNames)
+	if !keyed || !configKeyed || !liveKeyed {
+		for i := range max(len(configElements), len(liveElements)) {
+			elementPath := path + "[" + strconv.Itoa(i) + "]"
+			switch {
+			case i >= len(configElements):
+				*changes = append(*changes, newChange(elementPath, Added, cty.NilVal, liveElements[i]))
+			case i >= len(liveElements):
+				*changes = append(*changes, newChange(elementPath, Removed, configElements[i], cty.NilVal))
+			default:
+				// elements of a list have no attribute name
+				diffValue(elementPath, "", configElements[i], liveElements[i], changes)
+			}
+		}
+		return
+	}
+
+	liveIndexes := make(map[string]int, len(liveKeys))
+	for i, key := range liveKeys {
+		liveIndexes[key] = i
+	}
+	configIndexes := make(map[string]int, len(configKeys))
+	for i, key := range configKeys {
+		configIndexes[key] = i
+		elementPath := path + "[" + strconv.Quote(key) + "]"
+		liveIndex, ok := liveIndexes[key]
+		if !ok {
+			*changes = append(*changes, newChange(elementPath, Removed, configElements[i], cty.NilVal))
+			continue
+		}
+		diffValue(elementPath, "", configElements[i], liveElements[liveIndex], changes)
+	}
+	for i, key := range liveKeys {
+		if _, ok := configIndexes[key]; !ok {
+			*changes = append(*changes, newChange(path+"["+strconv.Quote(key)+"]", Added, cty.NilVal, liveElements[i]))
+		}
+	}
+}
+
+// keysOf returns the keys of the elements, which are the values of the attributes or the elements themselves
+// if no attribute is given. It returns false if any key is unknown or not unique.
+func keysOf(elements []cty.Value, attributeNames []string) ([]string, bool) {
+	keys := make([]string, len(elements))
+	seen := make(map[string]bool, len(elements))
+	for i, element := range elements {
+		values := []cty.Value{element}
+		if len(attributeNames) > 0 {
+			if !isObject(element) {
+				return nil, false
+			}
+			attributes := attributesOf(element)
+			values = values[:0]
+			for _, attributeName := range attributeNames {
+				values = append(values, normalize(attributes[attributeName]))
+			}
+		}
+		parts := make([]string, 0, len(values))
+		for _, value := range values {
+			if !value.IsKnown() || value.IsNull() || !value.Type().IsPrimitiveType() {
+				return nil, false
+			}
+			part, err := ctyjson.Marshal(value, value.Type())
+			if err != nil {
+				return nil, false
+			}
+			parts = append(parts, strings.Trim(string(part), `"`))
+		}
+		keys[i] = strings.Join(parts, ":")
+		if seen[keys[i]] {
+			return nil, false
+		}
+		seen[keys[i]] = true
+	}
+
+	return keys, true
+}
+
+// normalize converts missing values and zero values to null.
+func normalize(value cty.Value) cty.Value {
+	if value == cty.NilVal {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	if value.IsNull() || !value.IsKnown() {
+		return value
+	}
+	ty := value.Type()
+	switch {
+	case ty == cty.Bool && value.False(),
+		ty == cty.String && value.AsString() == "",
+		ty == cty.Number && value.AsBigFloat().Sign() == 0,
+		(ty.IsCollectionType() || ty.IsTupleType() || ty.IsObjectType()) && value.LengthInt() == 0:
+		return cty.NullVal(ty)
+	}
+
+	return value
+}
+
+// equals compares primitive values. Values in the configuration are converted to the type of the live values,
+// like Terraform does, and JSON strings are compared by their content.
+func equals(name string, configValue cty.Value, liveValue cty.Value) bool {
+	if configValue.IsNull() || liveValue.IsNull() || !configValue.IsWhollyKnown() || !liveValue.IsWhollyKnown() {
+		return false
+	}
+	configValue, err := convert.Convert(configValue, liveValue.Type())
+	if err != nil {
+		return false
+	}
+	if strings.HasSuffix(name, "_json") && configValue.Type() == cty.String && liveValue.Type() == cty.String {
+		var configJSON, liveJSON any
+		if json.Unmarshal([]byte(configValue.AsString()), &configJSON) == nil && json.Unmarshal([]byte(liveValue.AsString()), &liveJSON) == nil {
+			return reflect.DeepEqual(configJSON, liveJSON)
+		}
+	}
+
+	return configValue.Equals(liveValue).True()
+}
+
+func isObject(value cty.Value) bool {
+	ty := value.Type()
+	return value.IsNull() || ty.IsObjectType() || ty.IsMapType()
+}
+
+func isList(value cty.Value) bool {
+	ty := value.Type()
+	return value.IsNull() || ty.IsTupleType() || ty.IsListType() || ty.IsSetType()
+}
+
+func attributesOf(value cty.Value) map[string]cty.Value {
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+	return value.AsValueMap()
+}
+
+func elementsOf(value cty.Value) []cty.Value {
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+	return value.AsValueSlice()
+}
+
+func newChange(path string, change ChangeType, configValue cty.Value, liveValue cty.Value) Change {
+	return Change{
+		Path:   path,
+		Change: change,
+		Config: toJSON(configValue),
+		Live:   toJSON(liveValue),
+	}
+}
+
+// toJSON encodes a value to JSON. Unknown values, e.g. references to segments which do not exist in Unleash,
+// are encoded as "(unknown)".
+func toJSON(value cty.Value) json.RawMessage {
+	if value == cty.NilVal || value.IsNull() {
+		return nil
+	}
+	if !value.IsWhollyKnown() {
+		return json.RawMessage(`"(unknown)"`)
+	}
+	b, err := ctyjson.Marshal(value, value.Type())
+	if err != nil {
+		return json.RawMessage(strconv.Quote(value.GoString()))
+	}
+
+	return b
+}
@@ -0,0 +1,152 @@
+// Package drift compares unleash_feature resources in Terraform configuration with the live features in Unleash.
+package drift
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/generator"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+// ChangeType describes how Unleash differs from the configuration.
+type ChangeType string
+
+const (
+	// Added is something which exists in Unleash but not in the configuration.
+	Added ChangeType = "added"
+	// Removed is something which exists in the configuration but not in Unleash.
+	Removed ChangeType = "removed"
+	// Changed is something whose value in Unleash differs from the configuration.
+	Changed ChangeType = "changed"
+)
+
+// Report holds the drifted features sorted by project and name.
+type Report struct {
+	Features []FeatureDrift `json:"features"`
+}
+
+// HasDrift reports whether any feature drifted.
+func (r Report) HasDrift() bool {
+	return len(r.Features) > 0
+}
+
+// FeatureDrift describes how a feature in Unleash differs from its configuration.
+type FeatureDrift struct {
+	Project string `json:"project"`
+	Name    string `json:"name"`
+	// Address and File locate the resource in the configuration. They are empty for added features.
+	Address string     `json:"address,omitempty"`
+	File    string     `json:"file,omitempty"`
+	Change  ChangeType `json:"change"`
+	// Changes holds the differences of a changed feature.
+	Changes []Change `json:"changes,omitempty"`
+}
+
+// Change describes a difference of an attribute, e.g. `environments.production.strategies[0].parameters.rollout`.
+type Change struct {
+	Path   string     `json:"path"`
+	Change ChangeType `json:"change"`
+	// Config and Live are JSON encoded values which are omitted when they are null.
+	Config json.RawMessage `json:"config,omitempty"`
+	Live   json.RawMessage `json:"live,omitempty"`
+}
+
+// Detect compares the unleash_feature resources in the .tf files under configDir, including child modules,
+// with the features of their projects in Unleash. Features of projectIDs are also checked for features
+// which are only in Unleash.
+func Detect(client unleash.ClientWithResponsesInterface, configDir string, projectIDs []string, opts ...generator.Option) (Report, error) {
+	segmentIDs, err := getSegmentIDs(client)
+	if err != nil {
+		return Report{}, err
+	}
+	configFeatures, err := loadConfig(configDir, segmentIDs)
+	if err != nil {
+		return Report{}, err
+	}
+
+	projects := make(map[string]bool)
+	for _, projectID := range projectIDs {
+		projects[projectID] = true
+	}
+	for key := range configFeatures {
+		projects[key.project] = true
+	}
+	liveFeatures := make(map[featureKey]feature)
+	for projectID := range projects {
+		err := loadLiveFeatures(client, projectID, liveFeatures, opts)
+		if err != nil {
+			return Report{}, err
+		}
+	}
+
+	return compareFeatures(configFeatures, liveFeatures), nil
+}
+
+// loadLiveFeatures generates the features of a project and loads them as if they were configured.
+// Segments are not generated so that strategies keep literal segment IDs.
+// Tag types and strategies are not generated since only features are compared.
+func loadLiveFeatures(client unleash.ClientWithResponsesInterface, projectID string, features map[featureKey]feature, opts []generator.Option) error {
+	tf := &bytes.Buffer{}
+	err := generator.Generate(client, projectID, tf, io.Discard, append(opts, generator.WithoutSegments(), generator.WithoutTagTypes(), generator.WithoutStrategies())...)
+	// the configured features of a deleted project are reported as removed
+	if errors.Is(err, unleash.ErrProjectNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to generate project %s: %w", projectID, err)
+	}
+
+	return parseFeatures("unleash/"+projectID+".tf", tf.Bytes(), nil, features)
+}
+
+func compareFeatures(configFeatures map[featureKey]feature, liveFeatures map[featureKey]feature) Report {
+	keys := make([]featureKey, 0, len(configFeatures)+len(liveFeatures))
+	for key := range configFeatures {
+		keys = append(keys, key)
+	}
+	for key := range liveFeatures {
+		if _, ok := configFeatures[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].project != keys[j].project {
+			return keys[i].project < keys[j].project
+		}
+		return keys[i].name < keys[j].name
+	})
+
+	report := Report{
+		Features: []FeatureDrift{},
+	}
+	for _, key := range keys {
+		configFeature, inConfig := configFeatures[key]
+		liveFeature, inLive := liveFeatures[key]
+		featureDrift := FeatureDrift{
+			Project: key.project,
+			Name:    key.name,
+			Address: configFeature.address,
+			File:    configFeature.filename,
+		}
+		switch {
+		case !inLive:
+			featureDrift.Change = Removed
+		case !inConfig:
+			featureDrift.Change = Added
+		default:
+			featureDrift.Change = Changed
+			featureDrift.Changes = diffFeature(configFeature, liveFeature)
+			if len(featureDrift.Changes) == 0 {
+				continue
+			}
+		}
+		report.Features = append(report.Features, featureDrift)
+	}
+
+	return report
+}
@@ -0,0 +1,272 @@
+package drift_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/drift"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/generator"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+func TestDetect(t *testing.T) {
+	server := inmem.CreateTestServer()
+	port := server.Start(t)
+
+	client, err := unleash.CreateClient("http://localhost:"+strconv.Itoa(port), "any")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, _ = server.CreateSegment(ctx, unleash.CreateSegmentRequestObject{
+		Body: &unleash.CreateSegmentJSONRequestBody{
+			Name:        "QA",
+			Constraints: []unleash.ConstraintSchema{},
+		},
+	})
+	for _, featureName := range []string{"feature.a", "feature.b"} {
+		_, _ = server.CreateFeature(ctx, unleash.CreateFeatureRequestObject{
+			ProjectId: "default",
+			Body: &unleash.CreateFeatureJSONRequestBody{
+				Name: featureName,
+				Type: ptr.ToPtr("release"),
+			},
+		})
+	}
+	_, _ = server.AddFeatureStrategy(ctx, unleash.AddFeatureStrategyRequestObject{
+		ProjectId:   "default",
+		FeatureName: "feature.a",
+		Environment: "development",
+		Body: &unleash.AddFeatureStrategyJSONRequestBody{
+			Name:     "default",
+			Segments: ptr.ToPtr([]float32{1}),
+		},
+	})
+
+	configDir := t.TempDir()
+	tf := &bytes.Buffer{}
+	err = generator.Generate(client, "default", tf, &bytes.Buffer{})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "main.tf"), tf.Bytes(), 0o644))
+
+	report, err := drift.Detect(client, configDir, nil)
+	require.NoError(t, err)
+	assert.False(t, report.HasDrift(), report)
+
+	// hand edits in Unleash
+	_, _ = server.AddFeatureStrategy(ctx, unleash.AddFeatureStrategyRequestObject{
+		ProjectId:   "default",
+		FeatureName: "feature.a",
+		Environment: "development",
+		Body: &unleash.AddFeatureStrategyJSONRequestBody{
+			Name: "userWithId",
+		},
+	})
+	_, _ = server.ToggleFeatureEnvironmentOn(ctx, unleash.ToggleFeatureEnvironmentOnRequestObject{
+		ProjectId:   "default",
+		FeatureName: "feature.b",
+		Environment: "production",
+	})
+	_, _ = server.CreateFeature(ctx, unleash.CreateFeatureRequestObject{
+		ProjectId: "default",
+		Body: &unleash.CreateFeatureJSONRequestBody{
+			Name: "feature.c",
+			Type: ptr.ToPtr("release"),
+		},
+	})
+	require.NoError(t, os.MkdirAll(filepath.Join(configDir, "modules", "other"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "modules", "other", "feature.tf"), []byte(`resource "unleash_feature" "d" {
+  project = "default"
+  name    = "feature.d"
+  type    = "release"
+}`), 0o644))
+
+	report, err = drift.Detect(client, configDir, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []drift.FeatureDrift{
+		{
+			Project: "default",
+			Name:    "feature.a",
+			Address: "unleash_feature.feature_a",
+			File:    "main.tf",
+			Change:  drift.Changed,
+			Changes: []drift.Change{
+				{
+					Path:   "environments.development.strategies[1]",
+					Change: drift.Added,
+					Live:   json.RawMessage(`{"constraints":null,"disabled":false,"name":"userWithId","parameters":null,"segments":null,"sort_order":null,"title":null,"variants":null}`),
+				},
+			},
+		},
+		{
+			Project: "default",
+			Name:    "feature.b",
+			Address: "unleash_feature.feature_b",
+			File:    "main.tf",
+			Change:  drift.Changed,
+			Changes: []drift.Change{
+				{
+					Path:   "environments.production.enabled",
+					Change: drift.Changed,
+					Config: json.RawMessage(`false`),
+					Live:   json.RawMessage(`true`),
+				},
+			},
+		},
+		{
+			Project: "default",
+			Name:    "feature.c",
+			Change:  drift.Added,
+		},
+		{
+			Project: "default",
+			Name:    "feature.d",
+			Address: "unleash_feature.d",
+			File:    "modules/other/feature.tf",
+			Change:  drift.Removed,
+		},
+	}, report.Features)
+
+	text := &bytes.Buffer{}
+	require.NoError(t, report.WriteText(text))
+	assert.Contains(t, text.String(), "~ default/feature.b (unleash_feature.feature_b in main.tf)\n    ~ environments.production.enabled: false => true\n")
+	assert.Contains(t, text.String(), "4 features drifted\n")
+}
+
+func TestDetectUnsupportedExpression(t *testing.T) {
+	server := inmem.CreateTestServer()
+	port := server.Start(t)
+
+	client, err := unleash.CreateClient("http://localhost:"+strconv.Itoa(port), "any")
+	require.NoError(t, err)
+
+	configDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "main.tf"), []byte(`resource "unleash_feature" "a" {
+  project = var.project
+  name    = "feature.a"
+  type    = "release"
+}`), 0o644))
+
+	_, err = drift.Detect(client, configDir, nil)
+	assert.ErrorContains(t, err, "failed to evaluate project of unleash_feature.a")
+}
+
+func TestDetectMissingProject(t *testing.T) {
+	server := inmem.CreateTestServer()
+	port := server.Start(t)
+	server.InjectFault(inmem.Fault{Method: http.MethodGet, PathPrefix: "/api/admin/projects/deleted/", StatusCode: 404})
+
+	client, err := unleash.CreateClient("http://localhost:"+strconv.Itoa(port), "any")
+	require.NoError(t, err)
+
+	configDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "main.tf"), []byte(`resource "unleash_feature" "a" {
+  project = "deleted"
+  name    = "feature.a"
+  type    = "release"
+}`), 0o644))
+
+	report, err := drift.Detect(client, configDir, nil)
+	require.NoError(t, err)
+
+	require.Len(t, report.Features, 1)
+	assert.Equal(t, "deleted", report.Features[0].Project)
+	assert.Equal(t, "feature.a", report.Features[0].Name)
+	assert.Equal(t, drift.Removed, report.Features[0].Change)
+}
+
+func TestDetectUnmanagedAttributes(t *testing.T) {
+	server := inmem.CreateTestServer()
+	port := server.Start(t)
+
+	client, err := unleash.CreateClient("http://localhost:"+strconv.Itoa(port), "any")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for _, featureName := range []string{"feature.a", "feature.parent"} {
+		_, _ = server.CreateFeature(ctx, unleash.CreateFeatureRequestObject{
+			ProjectId: "default",
+			Body: &unleash.CreateFeatureJSONRequestBody{
+				Name: featureName,
+				Type: ptr.ToPtr("release"),
+			},
+		})
+	}
+	// tags and dependencies are not specified
+	_, _ = server.AddTag(ctx, unleash.AddTagRequestObject{
+		FeatureName: "feature.a",
+		Body: &unleash.AddTagJSONRequestBody{
+			Type:  "simple",
+			Value: "ui",
+		},
+	})
+	_, _ = server.AddFeatureDependency(ctx, unleash.AddFeatureDependencyRequestObject{
+		ProjectId:   "default",
+		FeatureName: "feature.a",
+		Body: &unleash.AddFeatureDependencyJSONRequestBody{
+			Feature: "feature.parent",
+		},
+	})
+	// managed by unleash_feature_strategy
+	_, _ = server.AddFeatureStrategy(ctx, unleash.AddFeatureStrategyRequestObject{
+		ProjectId:   "default",
+		FeatureName: "feature.a",
+		Environment: "production",
+		Body: &unleash.AddFeatureStrategyJSONRequestBody{
+			Name: "default",
+		},
+	})
+	// managed by unleash_feature_environment
+	_, _ = server.ToggleFeatureEnvironmentOn(ctx, unleash.ToggleFeatureEnvironmentOnRequestObject{
+		ProjectId:   "default",
+		FeatureName: "feature.a",
+		Environment: "production",
+	})
+
+	configDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "main.tf"), []byte(`resource "unleash_feature" "a" {
+  project = "default"
+  name    = "feature.a"
+  type    = "release"
+  environments = {
+    development = {
+      enabled           = false
+      manage_strategies = false
+    }
+    production = {
+      manage_enabled    = false
+      manage_strategies = false
+    }
+  }
+}
+
+resource "unleash_feature" "parent" {
+  project = "default"
+  name    = "feature.parent"
+  type    = "release"
+  environments = {
+    development = {
+      enabled           = false
+      manage_strategies = false
+    }
+    production = {
+      enabled           = false
+      manage_strategies = false
+    }
+  }
+}`), 0o644))
+
+	report, err := drift.Detect(client, configDir, nil)
+	require.NoError(t, err)
+	assert.False(t, report.HasDrift(), report)
+}
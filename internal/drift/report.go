@@ -0,0 +1,63 @@
+package drift
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+var changeSymbols = map[ChangeType]string{
+	Added:   "+",
+	Removed: "-",
+	Changed: "~",
+}
+
+// WriteText writes a human-readable report, e.g.
+//
+//	~ default/feature_1 (unleash_feature.feature_1 in main.tf)
+//	    ~ environments.production.enabled: false => true
+func (r Report) WriteText(w io.Writer) error {
+	for _, feature := range r.Features {
+		location := ""
+		if feature.Address != "" {
+			location = fmt.Sprintf(" (%s in %s)", feature.Address, feature.File)
+		}
+		if _, err := fmt.Fprintf(w, "%s %s/%s%s\n", changeSymbols[feature.Change], feature.Project, feature.Name, location); err != nil {
+			return err
+		}
+		for _, change := range feature.Changes {
+			if _, err := fmt.Fprintf(w, "    %s %s: %s\n", changeSymbols[change.Change], change.Path, formatChange(change)); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d features drifted\n", len(r.Features))
+
+	return err
+}
+
+func formatChange(change Change) string {
+	switch change.Change {
+	case Added:
+		return formatValue(change.Live)
+	case Removed:
+		return formatValue(change.Config)
+	default:
+		return formatValue(change.Config) + " => " + formatValue(change.Live)
+	}
+}
+
+func formatValue(value json.RawMessage) string {
+	if value == nil {
+		return "null"
+	}
+	return string(value)
+}
+
+// WriteJSON writes the report as an indented JSON document.
+func (r Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
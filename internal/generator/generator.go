@@ -5,9 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
+	"unicode"
 
+	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/zclconf/go-cty/cty"
@@ -15,18 +19,70 @@ import (
 	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
 )
 
-func Generate(client unleash.ClientWithResponsesInterface, projectID string, tfWriter io.Writer, importWriter io.Writer) error {
-	ctx := context.Background()
+type generator struct {
+	ctx     context.Context
+	client  unleash.ClientWithResponsesInterface
+	options options
+	// resourceNames holds the generated resource names by resource type to keep them unique
+	resourceNames map[string]map[string]bool
+	// segmentNames holds the resource names of the segments being generated by segment ID
+	segmentNames map[int]string
+	// segmentImportIDCounts holds the number of segments matching each import ID by name
+	segmentImportIDCounts map[string]int
+}
+
+func newGenerator(client unleash.ClientWithResponsesInterface, opts []Option) (*generator, error) {
+	o, err := toOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &generator{
+		ctx:                   context.Background(),
+		client:                client,
+		options:               o,
+		resourceNames:         make(map[string]map[string]bool),
+		segmentNames:          make(map[int]string),
+		segmentImportIDCounts: make(map[string]int),
+	}, nil
+}
+
+// GeneratedFiles holds resources and their import blocks generated for a project.
+type GeneratedFiles struct {
+	// ProjectID is empty for resources which do not belong to any project, e.g. global segments, tag types and strategies.
+	ProjectID string
+	Tf        []byte
+	ImportTf  []byte
+}
+
+func Generate(client unleash.ClientWithResponsesInterface, projectID string, tfWriter io.Writer, importWriter io.Writer, opts ...Option) error {
+	g, err := newGenerator(client, opts)
+	if err != nil {
+		return err
+	}
 	hclFile := hclwrite.NewEmptyFile()
 	hclBody := hclFile.Body()
 	importHclFile := hclwrite.NewEmptyFile()
 	importHclBody := importHclFile.Body()
 
-	err := genFeatures(ctx, client, projectID, hclBody, importHclBody)
+	segments, err := g.getSegments()
+	if err != nil {
+		return err
+	}
+	g.nameSegments(segments)
+	err = g.genFeatures(projectID, hclBody, importHclBody)
 	if err != nil {
 		return err
 	}
-	err = genSegments(ctx, client, hclBody, importHclBody)
+	err = g.genSegments(segments, hclBody, importHclBody)
+	if err != nil {
+		return err
+	}
+	err = g.genTagTypes(hclBody, importHclBody)
+	if err != nil {
+		return err
+	}
+	err = g.genStrategies(hclBody, importHclBody)
 	if err != nil {
 		return err
 	}
@@ -39,52 +95,236 @@ func Generate(client unleash.ClientWithResponsesInterface, projectID string, tfW
 	return err
 }
 
-func genFeatures(ctx context.Context, client unleash.ClientWithResponsesInterface, projectID string, hclBody *hclwrite.Body, importHclBody *hclwrite.Body) error {
-	fetchedFeatures, err := unleash.GetFeatures(ctx, client, projectID)
+// GenerateProjects generates resources of the projects or all projects if no project is given.
+// Global segments, tag types and custom strategies are generated in the files without project ID, which come first,
+// while project segments are generated in the files of their project. Resource names are unique across all files.
+func GenerateProjects(client unleash.ClientWithResponsesInterface, projectIDs []string, opts ...Option) ([]GeneratedFiles, error) {
+	g, err := newGenerator(client, opts)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if len(projectIDs) == 0 {
+		projectIDs, err = g.getProjectIDs()
+		if err != nil {
+			return nil, err
+		}
+	}
+	segments, err := g.getSegments()
+	if err != nil {
+		return nil, err
+	}
+	segmentsByProject := make(map[string][]unleash.AdminSegmentSchema)
+	for _, segment := range segments {
+		projectID := ""
+		if segment.Project != nil {
+			projectID = *segment.Project
+		}
+		segmentsByProject[projectID] = append(segmentsByProject[projectID], segment)
+	}
+	g.nameSegments(segmentsByProject[""])
+	for _, projectID := range projectIDs {
+		g.nameSegments(segmentsByProject[projectID])
 	}
 
-	sort.Sort(byFeatureName(fetchedFeatures))
+	hclFile := hclwrite.NewEmptyFile()
+	importHclFile := hclwrite.NewEmptyFile()
+	err = g.genSegments(segmentsByProject[""], hclFile.Body(), importHclFile.Body())
+	if err != nil {
+		return nil, err
+	}
+	err = g.genTagTypes(hclFile.Body(), importHclFile.Body())
+	if err != nil {
+		return nil, err
+	}
+	err = g.genStrategies(hclFile.Body(), importHclFile.Body())
+	if err != nil {
+		return nil, err
+	}
+	generated := []GeneratedFiles{
+		{
+			Tf:       hclFile.Bytes(),
+			ImportTf: importHclFile.Bytes(),
+		},
+	}
 
-	for _, fetchedFeature := range fetchedFeatures {
-		if fetchedFeature.Feature.Archived != nil && *fetchedFeature.Feature.Archived {
-			continue
+	for _, projectID := range projectIDs {
+		hclFile := hclwrite.NewEmptyFile()
+		importHclFile := hclwrite.NewEmptyFile()
+		err := g.genFeatures(projectID, hclFile.Body(), importHclFile.Body())
+		if err != nil {
+			return nil, err
 		}
-		resourceName := strings.ReplaceAll(fetchedFeature.Feature.Name, ".", "_")
-
-		resource := hclBody.AppendNewBlock("resource", []string{"unleash_feature", resourceName})
-		resourceBody := resource.Body()
-		resourceBody.SetAttributeValue("project", cty.StringVal(fetchedFeature.FetchedProject))
-		resourceBody.SetAttributeValue("name", cty.StringVal(fetchedFeature.Feature.Name))
-		resourceBody.SetAttributeValue("type", cty.StringVal(*fetchedFeature.Feature.Type))
-		if fetchedFeature.Feature.ImpressionData != nil {
-			resourceBody.SetAttributeValue("impression_data", cty.BoolVal(*fetchedFeature.Feature.ImpressionData))
+		err = g.genSegments(segmentsByProject[projectID], hclFile.Body(), importHclFile.Body())
+		if err != nil {
+			return nil, err
 		}
-		if fetchedFeature.Feature.Description != nil && *fetchedFeature.Feature.Description != "" {
-			resourceBody.SetAttributeValue("description", cty.StringVal(*fetchedFeature.Feature.Description))
+		generated = append(generated, GeneratedFiles{
+			ProjectID: projectID,
+			Tf:        hclFile.Bytes(),
+			ImportTf:  importHclFile.Bytes(),
+		})
+	}
+
+	return generated, nil
+}
+
+func (g *generator) getProjectIDs() ([]string, error) {
+	projectsResp, err := g.client.GetProjectsWithResponse(g.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if projectsResp.StatusCode() > 299 {
+		return nil, fmt.Errorf("failed to get projects: %d %s", projectsResp.StatusCode(), string(projectsResp.Body))
+	}
+	projectIDs := make([]string, 0, len(projectsResp.JSON200.Projects))
+	for _, project := range projectsResp.JSON200.Projects {
+		projectIDs = append(projectIDs, project.Id)
+	}
+	sort.Strings(projectIDs)
+
+	return projectIDs, nil
+}
+
+// resourceName converts the name to a valid resource name which has not been used by other resources of the type.
+func (g *generator) resourceName(resourceType string, name string) string {
+	var sb strings.Builder
+	for i, r := range name {
+		switch {
+		case unicode.IsLetter(r) || r == '_':
+			sb.WriteRune(r)
+		case unicode.IsDigit(r) || r == '-':
+			if i == 0 {
+				sb.WriteRune('_')
+			}
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune('_')
 		}
-		environments, err := toEnvironmentMaps(fetchedFeature.Feature.Name, fetchedFeature.FetchedEnvironments)
+	}
+	resourceName := sb.String()
+	if resourceName == "" {
+		resourceName = "_"
+	}
+
+	names, ok := g.resourceNames[resourceType]
+	if !ok {
+		names = make(map[string]bool)
+		g.resourceNames[resourceType] = names
+	}
+	uniqueName := resourceName
+	for i := 2; names[uniqueName]; i++ {
+		uniqueName = resourceName + "_" + strconv.Itoa(i)
+	}
+	names[uniqueName] = true
+
+	return uniqueName
+}
+
+func (g *generator) genFeatures(projectID string, hclBody *hclwrite.Body, importHclBody *hclwrite.Body) error {
+	fetchedFeatures, err := g.getFeatures(projectID)
+	if err != nil {
+		return err
+	}
+	for _, fetchedFeature := range fetchedFeatures {
+		_, err := g.genFeature(fetchedFeature, "", hclBody, importHclBody)
 		if err != nil {
 			return err
 		}
-		resourceBody.SetAttributeValue("environments", environments)
-		hclBody.AppendNewline()
+	}
 
-		importBlock := importHclBody.AppendNewBlock("import", []string{})
-		importBody := importBlock.Body()
-		importBody.SetAttributeRaw("to", []*hclwrite.Token{
+	return nil
+}
+
+// getFeatures fetches the features of the project which match the options sorted by name.
+func (g *generator) getFeatures(projectID string) ([]unleash.FetchedFeature, error) {
+	fetchedFeatures, err := unleash.GetFeatures(g.ctx, g.client, projectID, unleash.WithConcurrency(g.options.concurrency))
+	if err != nil {
+		return nil, err
+	}
+	if g.options.archived {
+		archivedFeatures, err := unleash.GetArchivedFeatures(g.ctx, g.client, projectID)
+		if err != nil {
+			return nil, err
+		}
+		fetchedFeatures = append(fetchedFeatures, archivedFeatures...)
+	}
+
+	sort.Sort(byFeatureName(fetchedFeatures))
+
+	return slices.DeleteFunc(fetchedFeatures, func(fetchedFeature unleash.FetchedFeature) bool {
+		return (isArchived(fetchedFeature) && !g.options.archived) || !g.options.isFeatureIncluded(fetchedFeature.Feature)
+	}), nil
+}
+
+func isArchived(fetchedFeature unleash.FetchedFeature) bool {
+	return fetchedFeature.Feature.Archived != nil && *fetchedFeature.Feature.Archived
+}
+
+// genFeature generates the feature resource and its import block then returns the resource name. moduleAddress is
+// prepended to the resource address in the import block when the resource is generated in a child module, e.g. `module.release.`.
+func (g *generator) genFeature(fetchedFeature unleash.FetchedFeature, moduleAddress string, hclBody *hclwrite.Body, importHclBody *hclwrite.Body) (string, error) {
+	archived := isArchived(fetchedFeature)
+	resourceName := g.resourceName("unleash_feature", fetchedFeature.Feature.Name)
+
+	if archived {
+		hclBody.AppendUnstructuredTokens(hclwrite.Tokens{
 			{
-				Type:         hclsyntax.TokenQuotedLit,
-				Bytes:        []byte("unleash_feature." + resourceName),
-				SpacesBefore: 0,
+				Type:  hclsyntax.TokenComment,
+				Bytes: []byte("# archived feature which is revived when this resource is applied\n"),
 			},
 		})
-		importBody.SetAttributeValue("id", cty.StringVal(fetchedFeature.FetchedProject+"."+fetchedFeature.Feature.Name))
-		importHclBody.AppendNewline()
 	}
-
-	return nil
+	resource := hclBody.AppendNewBlock("resource", []string{"unleash_feature", resourceName})
+	resourceBody := resource.Body()
+	resourceBody.SetAttributeValue("project", cty.StringVal(fetchedFeature.FetchedProject))
+	resourceBody.SetAttributeValue("name", cty.StringVal(fetchedFeature.Feature.Name))
+	resourceBody.SetAttributeValue("type", cty.StringVal(*fetchedFeature.Feature.Type))
+	if fetchedFeature.Feature.ImpressionData != nil {
+		resourceBody.SetAttributeValue("impression_data", cty.BoolVal(*fetchedFeature.Feature.ImpressionData))
+	}
+	if fetchedFeature.Feature.Description != nil && *fetchedFeature.Feature.Description != "" {
+		resourceBody.SetAttributeValue("description", cty.StringVal(*fetchedFeature.Feature.Description))
+	}
+	environments, err := toEnvironmentMaps(fetchedFeature.Feature.Name, fetchedFeature.FetchedEnvironments)
+	if err != nil {
+		return "", err
+	}
+	if environments.IsNull() {
+		environments = cty.MapValEmpty(environmentType)
+	}
+	environmentTokens := hclwrite.TokensForValue(environments)
+	// segments in the root module cannot be referenced from child modules
+	if moduleAddress == "" {
+		environmentTokens = g.referenceSegments(environmentTokens)
+	}
+	resourceBody.SetAttributeRaw("environments", environmentTokens)
+	dependencies := toDependencies(fetchedFeature.Feature.Dependencies)
+	if !dependencies.IsNull() {
+		resourceBody.SetAttributeValue("dependencies", dependencies)
+	}
+	tags := toTags(fetchedFeature.Feature.Tags)
+	if !tags.IsNull() {
+		resourceBody.SetAttributeValue("tags", tags)
+	}
+	hclBody.AppendNewline()
+
+	// archived features cannot be imported
+	if archived {
+		return resourceName, nil
+	}
+	importBlock := importHclBody.AppendNewBlock("import", []string{})
+	importBody := importBlock.Body()
+	importBody.SetAttributeRaw("to", []*hclwrite.Token{
+		{
+			Type:         hclsyntax.TokenQuotedLit,
+			Bytes:        []byte(moduleAddress + "unleash_feature." + resourceName),
+			SpacesBefore: 0,
+		},
+	})
+	importBody.SetAttributeValue("id", cty.StringVal(fetchedFeature.FetchedProject+"."+fetchedFeature.Feature.Name))
+	importHclBody.AppendNewline()
+
+	return resourceName, nil
 }
 
 type byFeatureName []unleash.FetchedFeature
@@ -93,6 +333,47 @@ func (a byFeatureName) Len() int           { return len(a) }
 func (a byFeatureName) Less(i, j int) bool { return a[i].Feature.Name < a[j].Feature.Name }
 func (a byFeatureName) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 
+func toDependencies(dependencies *[]struct {
+	Enabled  *bool     `json:"enabled,omitempty"`
+	Feature  string    `json:"feature"`
+	Variants *[]string `json:"variants,omitempty"`
+}) cty.Value {
+	if dependencies == nil || len(*dependencies) == 0 {
+		return cty.NullVal(cty.Set(dependencyType))
+	}
+	dependencyValues := make([]cty.Value, 0, len(*dependencies))
+	for _, dependency := range *dependencies {
+		attributes := map[string]cty.Value{
+			"feature":  cty.StringVal(dependency.Feature),
+			"enabled":  cty.BoolVal(dependency.Enabled == nil || *dependency.Enabled),
+			"variants": cty.NullVal(cty.Set(cty.String)),
+		}
+		if dependency.Variants != nil && len(*dependency.Variants) > 0 {
+			variantValues := make([]cty.Value, 0, len(*dependency.Variants))
+			for _, variant := range *dependency.Variants {
+				variantValues = append(variantValues, cty.StringVal(variant))
+			}
+			attributes["variants"] = cty.SetVal(variantValues)
+		}
+		dependencyValues = append(dependencyValues, cty.ObjectVal(attributes))
+	}
+	return cty.SetVal(dependencyValues)
+}
+
+func toTags(tags *[]unleash.TagSchema) cty.Value {
+	if tags == nil || len(*tags) == 0 {
+		return cty.NullVal(cty.Set(tagType))
+	}
+	tagValues := make([]cty.Value, 0, len(*tags))
+	for _, tag := range *tags {
+		tagValues = append(tagValues, cty.ObjectVal(map[string]cty.Value{
+			"type":  cty.StringVal(tag.Type),
+			"value": cty.StringVal(tag.Value),
+		}))
+	}
+	return cty.SetVal(tagValues)
+}
+
 func toEnvironmentMaps(featureName string, environments []unleash.FetchedEnvironment) (cty.Value, error) {
 	if len(environments) == 0 {
 		return cty.NullVal(cty.Map(environmentType)), nil
@@ -345,20 +626,97 @@ func toStrategyVariants(variants *[]unleash.StrategyVariantSchema) cty.Value {
 	return cty.ListVal(variantValues)
 }
 
-func genSegments(ctx context.Context, client unleash.ClientWithResponsesInterface, hclBody *hclwrite.Body, importHclBody *hclwrite.Body) error {
-	segmentsResp, err := client.GetSegmentsWithResponse(ctx)
+func (g *generator) getSegments() ([]unleash.AdminSegmentSchema, error) {
+	if g.options.skipSegments {
+		return nil, nil
+	}
+	segmentsResp, err := g.client.GetSegmentsWithResponse(g.ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if segmentsResp.StatusCode() > 299 {
-		return fmt.Errorf("failed to get segments: %d %s", segmentsResp.StatusCode(), string(segmentsResp.Body))
+		return nil, fmt.Errorf("failed to get segments: %d %s", segmentsResp.StatusCode(), string(segmentsResp.Body))
 	}
 	if segmentsResp.JSON200.Segments == nil {
-		return nil
+		return nil, nil
 	}
 	for _, segment := range *segmentsResp.JSON200.Segments {
-		resourceName := strings.ToLower(segment.Name)
-		resourceName = strings.ReplaceAll(strings.ReplaceAll(resourceName, ".", "_"), " ", "_")
+		for _, importID := range segmentImportIDsByName(segment) {
+			g.segmentImportIDCounts[importID]++
+		}
+	}
+
+	return *segmentsResp.JSON200.Segments, nil
+}
+
+// segmentImportIDsByName returns the import IDs which match the segment by name, most specific first.
+func segmentImportIDsByName(segment unleash.AdminSegmentSchema) []string {
+	if segment.Project != nil && *segment.Project != "" {
+		return []string{*segment.Project + "/" + segment.Name, "name:" + segment.Name}
+	}
+	return []string{"name:" + segment.Name}
+}
+
+// segmentImportID returns the ID of the import block of a segment.
+func (g *generator) segmentImportID(segment unleash.AdminSegmentSchema) string {
+	if g.options.segmentImportByName {
+		for _, importID := range segmentImportIDsByName(segment) {
+			if g.segmentImportIDCounts[importID] == 1 {
+				return importID
+			}
+		}
+	}
+
+	return fmt.Sprintf("%d", segment.Id)
+}
+
+// nameSegments assigns resource names to the segments so that strategies can reference them before they are generated.
+func (g *generator) nameSegments(segments []unleash.AdminSegmentSchema) {
+	for _, segment := range segments {
+		g.segmentNames[segment.Id] = g.resourceName("unleash_segment", strings.ToLower(segment.Name))
+	}
+}
+
+// referenceSegments replaces the segment IDs in `segments = [...]` of strategies with references to the segments
+// being generated, e.g. `unleash_segment.qa.id_int`, so that the generated code does not depend on the IDs of an instance.
+// IDs of the segments which are not generated are kept.
+func (g *generator) referenceSegments(tokens hclwrite.Tokens) hclwrite.Tokens {
+	referenced := make(hclwrite.Tokens, 0, len(tokens))
+	inSegments := false
+	for i, token := range tokens {
+		switch {
+		case token.Type == hclsyntax.TokenOBrack && i >= 2 &&
+			tokens[i-2].Type == hclsyntax.TokenIdent && string(tokens[i-2].Bytes) == "segments" && tokens[i-1].Type == hclsyntax.TokenEqual:
+			inSegments = true
+		case token.Type == hclsyntax.TokenCBrack:
+			inSegments = false
+		case inSegments && token.Type == hclsyntax.TokenNumberLit:
+			id, err := strconv.Atoi(string(token.Bytes))
+			if err != nil {
+				break
+			}
+			resourceName, ok := g.segmentNames[id]
+			if !ok {
+				break
+			}
+			traversal := hclwrite.TokensForTraversal(hcl.Traversal{
+				hcl.TraverseRoot{Name: "unleash_segment"},
+				hcl.TraverseAttr{Name: resourceName},
+				hcl.TraverseAttr{Name: "id_int"},
+			})
+			traversal[0].SpacesBefore = token.SpacesBefore
+			referenced = append(referenced, traversal...)
+			continue
+		}
+		referenced = append(referenced, token)
+	}
+
+	return referenced
+}
+
+func (g *generator) genSegments(segments []unleash.AdminSegmentSchema, hclBody *hclwrite.Body, importHclBody *hclwrite.Body) error {
+	for _, segment := range segments {
+		resourceName := g.segmentNames[segment.Id]
 
 		resource := hclBody.AppendNewBlock("resource", []string{"unleash_segment", resourceName})
 		resourceBody := resource.Body()
@@ -386,8 +744,131 @@ func genSegments(ctx context.Context, client unleash.ClientWithResponsesInterfac
 				SpacesBefore: 0,
 			},
 		})
-		importBody.SetAttributeValue("id", cty.StringVal(fmt.Sprintf("%d", segment.Id)))
+		importBody.SetAttributeValue("id", cty.StringVal(g.segmentImportID(segment)))
+		importHclBody.AppendNewline()
+	}
+	return nil
+}
+
+func (g *generator) genTagTypes(hclBody *hclwrite.Body, importHclBody *hclwrite.Body) error {
+	if g.options.skipTagTypes {
+		return nil
+	}
+	tagTypesResp, err := g.client.GetTagTypesWithResponse(g.ctx)
+	if err != nil {
+		return err
+	}
+	if tagTypesResp.StatusCode() > 299 {
+		return fmt.Errorf("failed to get tag types: %d %s", tagTypesResp.StatusCode(), string(tagTypesResp.Body))
+	}
+	for _, tagType := range tagTypesResp.JSON200.TagTypes {
+		// simple is built into Unleash so it should not be managed
+		if tagType.Name == "simple" {
+			continue
+		}
+		resourceName := g.resourceName("unleash_tag_type", strings.ToLower(tagType.Name))
+
+		resource := hclBody.AppendNewBlock("resource", []string{"unleash_tag_type", resourceName})
+		resourceBody := resource.Body()
+		resourceBody.SetAttributeValue("name", cty.StringVal(tagType.Name))
+		if tagType.Description != nil && *tagType.Description != "" {
+			resourceBody.SetAttributeValue("description", cty.StringVal(*tagType.Description))
+		}
+		if tagType.Icon != nil && *tagType.Icon != "" {
+			resourceBody.SetAttributeValue("icon", cty.StringVal(*tagType.Icon))
+		}
+
+		hclBody.AppendNewline()
+
+		importBlock := importHclBody.AppendNewBlock("import", []string{})
+		importBody := importBlock.Body()
+		importBody.SetAttributeRaw("to", []*hclwrite.Token{
+			{
+				Type:         hclsyntax.TokenQuotedLit,
+				Bytes:        []byte("unleash_tag_type." + resourceName),
+				SpacesBefore: 0,
+			},
+		})
+		importBody.SetAttributeValue("id", cty.StringVal(tagType.Name))
 		importHclBody.AppendNewline()
 	}
 	return nil
 }
+
+func (g *generator) genStrategies(hclBody *hclwrite.Body, importHclBody *hclwrite.Body) error {
+	if g.options.skipStrategies {
+		return nil
+	}
+	strategiesResp, err := g.client.GetAllStrategiesWithResponse(g.ctx)
+	if err != nil {
+		return err
+	}
+	if strategiesResp.StatusCode() > 299 {
+		return fmt.Errorf("failed to get strategies: %d %s", strategiesResp.StatusCode(), string(strategiesResp.Body))
+	}
+	for _, strategy := range strategiesResp.JSON200.Strategies {
+		// strategies bundled with Unleash are not editable so they should not be managed
+		if !strategy.Editable {
+			continue
+		}
+		resourceName := g.resourceName("unleash_strategy", strings.ToLower(strategy.Name))
+
+		resource := hclBody.AppendNewBlock("resource", []string{"unleash_strategy", resourceName})
+		resourceBody := resource.Body()
+		resourceBody.SetAttributeValue("name", cty.StringVal(strategy.Name))
+		if strategy.Title != nil && *strategy.Title != "" {
+			resourceBody.SetAttributeValue("title", cty.StringVal(*strategy.Title))
+		}
+		if strategy.Description != nil && *strategy.Description != "" {
+			resourceBody.SetAttributeValue("description", cty.StringVal(*strategy.Description))
+		}
+		if len(strategy.Parameters) > 0 {
+			resourceBody.SetAttributeValue("parameters", toStrategyParameters(strategy))
+		}
+		if strategy.Deprecated {
+			resourceBody.SetAttributeValue("deprecated", cty.True)
+		}
+
+		hclBody.AppendNewline()
+
+		importBlock := importHclBody.AppendNewBlock("import", []string{})
+		importBody := importBlock.Body()
+		importBody.SetAttributeRaw("to", []*hclwrite.Token{
+			{
+				Type:         hclsyntax.TokenQuotedLit,
+				Bytes:        []byte("unleash_strategy." + resourceName),
+				SpacesBefore: 0,
+			},
+		})
+		importBody.SetAttributeValue("id", cty.StringVal(strategy.Name))
+		importHclBody.AppendNewline()
+	}
+	return nil
+}
+
+func toStrategyParameters(strategy unleash.StrategySchema) cty.Value {
+	parameterValues := make([]cty.Value, 0, len(strategy.Parameters))
+	for _, parameter := range strategy.Parameters {
+		attributes := map[string]cty.Value{
+			"name":        cty.StringVal(""),
+			"type":        cty.StringVal(""),
+			"description": cty.NullVal(cty.String),
+			"required":    cty.NullVal(cty.Bool),
+		}
+		if parameter.Name != nil {
+			attributes["name"] = cty.StringVal(*parameter.Name)
+		}
+		if parameter.Type != nil {
+			attributes["type"] = cty.StringVal(*parameter.Type)
+		}
+		if parameter.Description != nil && *parameter.Description != "" {
+			attributes["description"] = cty.StringVal(*parameter.Description)
+		}
+		if parameter.Required != nil && *parameter.Required {
+			attributes["required"] = cty.True
+		}
+
+		parameterValues = append(parameterValues, cty.ObjectVal(attributes))
+	}
+	return cty.ListVal(parameterValues)
+}
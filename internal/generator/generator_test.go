@@ -32,6 +32,11 @@ func TestGenerate(t *testing.T) {
 		strategiesByEnvironment        map[string][]unleash.AddFeatureStrategyJSONRequestBody
 		variantsByEnvironment          map[string][]unleash.VariantSchema
 		segments                       []unleash.CreateSegmentRequestObject
+		parentFeatureName              string
+		dependencies                   []unleash.CreateDependentFeatureSchema
+		tagTypes                       []unleash.TagTypeSchema
+		tags                           []unleash.TagSchema
+		strategies                     []unleash.CreateStrategySchema
 		expectedTf                     string
 		expectedImportTf               string
 	}{
@@ -498,6 +503,328 @@ resource "unleash_segment" "qa" {
 import {
   to =unleash_segment.qa
   id = "4"
+}`,
+		},
+		{
+			name:                  "with dependency",
+			projectID:             "projectwithdependency",
+			featureName:           "test.feature.child",
+			variantsByEnvironment: map[string][]unleash.VariantSchema{},
+			parentFeatureName:     "test.feature.parent",
+			dependencies: []unleash.CreateDependentFeatureSchema{
+				{
+					Feature:  "test.feature.parent",
+					Enabled:  ptr.ToPtr(true),
+					Variants: ptr.ToPtr([]string{"blue", "red"}),
+				},
+			},
+			expectedTf: `resource "unleash_feature" "test_feature_child" {
+  project = "projectwithdependency"
+  name    = "test.feature.child"
+  type    = "release"
+  environments = {
+    development = {
+      enabled = false
+      strategies = [{
+        constraints = null
+        disabled    = false
+        name        = "flexibleRollout"
+        parameters = {
+          groupId    = "test.feature.child"
+          rollout    = "100"
+          stickiness = "default"
+        }
+        segments   = null
+        sort_order = null
+        title      = null
+        variants   = null
+      }]
+      variants = null
+    }
+    production = {
+      enabled = false
+      strategies = [{
+        constraints = null
+        disabled    = false
+        name        = "flexibleRollout"
+        parameters = {
+          groupId    = "test.feature.child"
+          rollout    = "100"
+          stickiness = "default"
+        }
+        segments   = null
+        sort_order = null
+        title      = null
+        variants   = null
+      }]
+      variants = null
+    }
+  }
+  dependencies = [{
+    enabled  = true
+    feature  = "test.feature.parent"
+    variants = ["blue", "red"]
+  }]
+}
+
+resource "unleash_feature" "test_feature_parent" {
+  project = "projectwithdependency"
+  name    = "test.feature.parent"
+  type    = "release"
+  environments = {
+    development = {
+      enabled = false
+      strategies = [{
+        constraints = null
+        disabled    = false
+        name        = "flexibleRollout"
+        parameters = {
+          groupId    = "test.feature.parent"
+          rollout    = "100"
+          stickiness = "default"
+        }
+        segments   = null
+        sort_order = null
+        title      = null
+        variants   = null
+      }]
+      variants = null
+    }
+    production = {
+      enabled = false
+      strategies = [{
+        constraints = null
+        disabled    = false
+        name        = "flexibleRollout"
+        parameters = {
+          groupId    = "test.feature.parent"
+          rollout    = "100"
+          stickiness = "default"
+        }
+        segments   = null
+        sort_order = null
+        title      = null
+        variants   = null
+      }]
+      variants = null
+    }
+  }
+}`,
+			expectedImportTf: `import {
+  to =unleash_feature.test_feature_child
+  id = "projectwithdependency.test.feature.child"
+}
+
+import {
+  to =unleash_feature.test_feature_parent
+  id = "projectwithdependency.test.feature.parent"
+}`,
+		},
+		{
+			name:        "with tags",
+			projectID:   "projectwithtag",
+			featureName: "test.feature.tagged",
+			tagTypes: []unleash.TagTypeSchema{
+				{
+					Name:        "team",
+					Description: ptr.ToPtr("The team owning the feature"),
+					Icon:        ptr.ToPtr("people"),
+				},
+			},
+			tags: []unleash.TagSchema{
+				{
+					Type:  "simple",
+					Value: "PAY-123",
+				},
+				{
+					Type:  "team",
+					Value: "payments",
+				},
+			},
+			expectedTf: `resource "unleash_feature" "test_feature_tagged" {
+  project = "projectwithtag"
+  name    = "test.feature.tagged"
+  type    = "release"
+  environments = {
+    development = {
+      enabled = false
+      strategies = [{
+        constraints = null
+        disabled    = false
+        name        = "flexibleRollout"
+        parameters = {
+          groupId    = "test.feature.tagged"
+          rollout    = "100"
+          stickiness = "default"
+        }
+        segments   = null
+        sort_order = null
+        title      = null
+        variants   = null
+      }]
+      variants = null
+    }
+    production = {
+      enabled = false
+      strategies = [{
+        constraints = null
+        disabled    = false
+        name        = "flexibleRollout"
+        parameters = {
+          groupId    = "test.feature.tagged"
+          rollout    = "100"
+          stickiness = "default"
+        }
+        segments   = null
+        sort_order = null
+        title      = null
+        variants   = null
+      }]
+      variants = null
+    }
+  }
+  tags = [{
+    type  = "simple"
+    value = "PAY-123"
+    }, {
+    type  = "team"
+    value = "payments"
+  }]
+}
+
+resource "unleash_tag_type" "team" {
+  name        = "team"
+  description = "The team owning the feature"
+  icon        = "people"
+}`,
+			expectedImportTf: `import {
+  to =unleash_feature.test_feature_tagged
+  id = "projectwithtag.test.feature.tagged"
+}
+
+import {
+  to =unleash_tag_type.team
+  id = "team"
+}`,
+		},
+		{
+			name:        "with custom strategy",
+			projectID:   "projectwithstrategy",
+			featureName: "test.feature.tenant",
+			strategiesByEnvironment: map[string][]unleash.AddFeatureStrategyJSONRequestBody{
+				"development": {
+					{
+						Name: "tenantAllowlist",
+						Parameters: &unleash.ParametersSchema{
+							"tenants": "acme",
+						},
+					},
+				},
+			},
+			strategies: []unleash.CreateStrategySchema{
+				{
+					Name:        "tenantAllowlist",
+					Title:       ptr.ToPtr("Tenant allowlist"),
+					Description: ptr.ToPtr("Enable for the listed tenants"),
+					Parameters: []struct {
+						Description *string                                    `json:"description,omitempty"`
+						Name        string                                     `json:"name"`
+						Required    *bool                                      `json:"required,omitempty"`
+						Type        unleash.CreateStrategySchemaParametersType `json:"type"`
+					}{
+						{
+							Name:        "tenants",
+							Type:        unleash.CreateStrategySchemaParametersTypeList,
+							Description: ptr.ToPtr("Tenant IDs"),
+							Required:    ptr.ToPtr(true),
+						},
+						{
+							Name: "rollout",
+							Type: unleash.CreateStrategySchemaParametersTypePercentage,
+						},
+					},
+				},
+				{
+					Name:       "regionRollout",
+					Deprecated: ptr.ToPtr(true),
+				},
+			},
+			expectedTf: `resource "unleash_feature" "test_feature_tenant" {
+  project = "projectwithstrategy"
+  name    = "test.feature.tenant"
+  type    = "release"
+  environments = {
+    development = {
+      enabled = false
+      strategies = [{
+        constraints = null
+        disabled    = false
+        name        = "tenantAllowlist"
+        parameters = {
+          tenants = "acme"
+        }
+        segments   = null
+        sort_order = null
+        title      = null
+        variants   = null
+      }]
+      variants = null
+    }
+    production = {
+      enabled = false
+      strategies = [{
+        constraints = null
+        disabled    = false
+        name        = "flexibleRollout"
+        parameters = {
+          groupId    = "test.feature.tenant"
+          rollout    = "100"
+          stickiness = "default"
+        }
+        segments   = null
+        sort_order = null
+        title      = null
+        variants   = null
+      }]
+      variants = null
+    }
+  }
+}
+
+resource "unleash_strategy" "regionrollout" {
+  name       = "regionRollout"
+  deprecated = true
+}
+
+resource "unleash_strategy" "tenantallowlist" {
+  name        = "tenantAllowlist"
+  title       = "Tenant allowlist"
+  description = "Enable for the listed tenants"
+  parameters = [{
+    description = "Tenant IDs"
+    name        = "tenants"
+    required    = true
+    type        = "list"
+    }, {
+    description = null
+    name        = "rollout"
+    required    = null
+    type        = "percentage"
+  }]
+}`,
+			expectedImportTf: `import {
+  to =unleash_feature.test_feature_tenant
+  id = "projectwithstrategy.test.feature.tenant"
+}
+
+import {
+  to =unleash_strategy.regionrollout
+  id = "regionRollout"
+}
+
+import {
+  to =unleash_strategy.tenantallowlist
+  id = "tenantAllowlist"
 }`,
 		},
 	}
@@ -507,6 +834,16 @@ import {
 			importWriter := &bytes.Buffer{}
 			ctx := context.Background()
 
+			if testCase.parentFeatureName != "" {
+				_, _ = server.CreateFeature(ctx, unleash.CreateFeatureRequestObject{
+					ProjectId: testCase.projectID,
+					Body: &unleash.CreateFeatureJSONRequestBody{
+						Name: testCase.parentFeatureName,
+						Type: ptr.ToPtr("release"),
+					},
+				})
+			}
+			removeFns := make([]func(), 0, len(testCase.segments)+len(testCase.tagTypes)+len(testCase.strategies))
 			_, _ = server.CreateFeature(ctx, unleash.CreateFeatureRequestObject{
 				ProjectId: testCase.projectID,
 				Body: &unleash.CreateFeatureJSONRequestBody{
@@ -516,6 +853,41 @@ import {
 					Type:           ptr.ToPtr("release"),
 				},
 			})
+			for _, dependency := range testCase.dependencies {
+				_, _ = server.AddFeatureDependency(ctx, unleash.AddFeatureDependencyRequestObject{
+					ProjectId:   testCase.projectID,
+					FeatureName: testCase.featureName,
+					Body:        &dependency,
+				})
+			}
+			for _, tagType := range testCase.tagTypes {
+				_, _ = server.CreateTagType(ctx, unleash.CreateTagTypeRequestObject{
+					Body: &tagType,
+				})
+				removeFns = append(removeFns, func() {
+					_, _ = server.DeleteTagType(ctx, unleash.DeleteTagTypeRequestObject{
+						Name: tagType.Name,
+					})
+				})
+			}
+			for _, strategy := range testCase.strategies {
+				_, _ = server.CreateStrategy(ctx, unleash.CreateStrategyRequestObject{
+					Body: &strategy,
+				})
+				removeFns = append(removeFns, func() {
+					_, _ = server.RemoveStrategy(ctx, unleash.RemoveStrategyRequestObject{
+						Name: strategy.Name,
+					})
+				})
+			}
+			if len(testCase.tags) > 0 {
+				_, _ = server.UpdateTags(ctx, unleash.UpdateTagsRequestObject{
+					FeatureName: testCase.featureName,
+					Body: &unleash.UpdateTagsJSONRequestBody{
+						AddedTags: testCase.tags,
+					},
+				})
+			}
 			for name, toggle := range testCase.environmentToggleByEnvironment {
 				if toggle {
 					_, _ = server.ToggleFeatureEnvironmentOn(ctx, unleash.ToggleFeatureEnvironmentOnRequestObject{
@@ -551,7 +923,6 @@ import {
 					},
 				})
 			}
-			removeFns := make([]func(), 0, len(testCase.segments))
 			for _, segment := range testCase.segments {
 				s, _ := server.CreateSegment(ctx, segment)
 				removeFns = append(removeFns, func() {
@@ -584,3 +955,461 @@ var newLineRegex = regexp.MustCompile("[\r\n]+")
 func convertMultipleSpacesToSingleSpace(v string) string {
 	return string(multipleSpacesRegex.ReplaceAll(newLineRegex.ReplaceAll([]byte(v), []byte("")), []byte(" ")))
 }
+
+func TestGenerateProjects(t *testing.T) {
+	server := inmem.CreateTestServer()
+	port := server.Start(t)
+
+	client, err := unleash.CreateClient("http://localhost:"+strconv.Itoa(port), "any")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for _, projectID := range []string{"alpha", "beta"} {
+		_, _ = server.CreateProject(ctx, unleash.CreateProjectRequestObject{
+			Body: &unleash.CreateProjectJSONRequestBody{
+				Id:   projectID,
+				Name: projectID,
+			},
+		})
+	}
+	for projectID, featureName := range map[string]string{"alpha": "test.feature", "beta": "test_feature"} {
+		_, _ = server.CreateFeature(ctx, unleash.CreateFeatureRequestObject{
+			ProjectId: projectID,
+			Body: &unleash.CreateFeatureJSONRequestBody{
+				Name: featureName,
+				Type: ptr.ToPtr("release"),
+			},
+		})
+	}
+	for _, segment := range []unleash.CreateSegmentJSONRequestBody{
+		{Name: "QA", Constraints: []unleash.ConstraintSchema{}},
+		{Name: "QA", Project: ptr.ToPtr("beta"), Constraints: []unleash.ConstraintSchema{}},
+		{Name: "Gamma", Project: ptr.ToPtr("gamma"), Constraints: []unleash.ConstraintSchema{}},
+	} {
+		_, _ = server.CreateSegment(ctx, unleash.CreateSegmentRequestObject{
+			Body: &segment,
+		})
+	}
+
+	var testCases = []struct {
+		name               string
+		projectIDs         []string
+		expectedProjectIDs []string
+		expectedImportTfs  []string
+	}{
+		{
+			name:               "all projects",
+			expectedProjectIDs: []string{"", "alpha", "beta", "default"},
+			expectedImportTfs: []string{
+				`import {
+  to =unleash_segment.qa
+  id = "1"
+}`,
+				`import {
+  to =unleash_feature.test_feature
+  id = "alpha.test.feature"
+}`,
+				`import {
+  to =unleash_feature.test_feature_2
+  id = "beta.test_feature"
+}
+
+import {
+  to =unleash_segment.qa_2
+  id = "2"
+}`,
+				``,
+			},
+		},
+		{
+			name:               "selected projects",
+			projectIDs:         []string{"beta"},
+			expectedProjectIDs: []string{"", "beta"},
+			expectedImportTfs: []string{
+				`import {
+  to =unleash_segment.qa
+  id = "1"
+}`,
+				`import {
+  to =unleash_feature.test_feature
+  id = "beta.test_feature"
+}
+
+import {
+  to =unleash_segment.qa_2
+  id = "2"
+}`,
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			generated, err := generator.GenerateProjects(client, testCase.projectIDs)
+			require.NoError(tt, err)
+
+			require.Len(tt, generated, len(testCase.expectedProjectIDs))
+			for i, files := range generated {
+				assert.Equal(tt, testCase.expectedProjectIDs[i], files.ProjectID)
+				assertTfEqual(tt, testCase.expectedImportTfs[i], string(files.ImportTf))
+			}
+		})
+	}
+}
+
+func TestGenerateOptions(t *testing.T) {
+	server := inmem.CreateTestServer()
+	port := server.Start(t)
+
+	client, err := unleash.CreateClient("http://localhost:"+strconv.Itoa(port), "any")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for featureName, featureType := range map[string]string{
+		"payment.checkout": "release",
+		"payment.refund":   "experiment",
+		"search.ranking":   "release",
+		"search.legacy":    "release",
+	} {
+		_, _ = server.CreateFeature(ctx, unleash.CreateFeatureRequestObject{
+			ProjectId: "default",
+			Body: &unleash.CreateFeatureJSONRequestBody{
+				Name: featureName,
+				Type: ptr.ToPtr(featureType),
+			},
+		})
+	}
+	_, _ = server.ArchiveFeature(ctx, unleash.ArchiveFeatureRequestObject{
+		ProjectId:   "default",
+		FeatureName: "search.legacy",
+	})
+	_, _ = server.CreateSegment(ctx, unleash.CreateSegmentRequestObject{
+		Body: &unleash.CreateSegmentJSONRequestBody{
+			Name:        "QA",
+			Constraints: []unleash.ConstraintSchema{},
+		},
+	})
+
+	var testCases = []struct {
+		name              string
+		options           []generator.Option
+		expectedResources []string
+		expectedImports   []string
+	}{
+		{
+			name:              "default",
+			expectedResources: []string{"unleash_feature.payment_checkout", "unleash_feature.payment_refund", "unleash_feature.search_ranking", "unleash_segment.qa"},
+			expectedImports:   []string{"unleash_feature.payment_checkout", "unleash_feature.payment_refund", "unleash_feature.search_ranking", "unleash_segment.qa"},
+		},
+		{
+			name:              "include and exclude",
+			options:           []generator.Option{generator.WithIncludedFeatures("payment.*"), generator.WithExcludedFeatures("*.refund"), generator.WithoutSegments()},
+			expectedResources: []string{"unleash_feature.payment_checkout"},
+			expectedImports:   []string{"unleash_feature.payment_checkout"},
+		},
+		{
+			name:              "types",
+			options:           []generator.Option{generator.WithFeatureTypes("experiment"), generator.WithoutSegments()},
+			expectedResources: []string{"unleash_feature.payment_refund"},
+			expectedImports:   []string{"unleash_feature.payment_refund"},
+		},
+		{
+			name:              "archived",
+			options:           []generator.Option{generator.WithIncludedFeatures("search.*"), generator.WithArchivedFeatures(), generator.WithoutSegments()},
+			expectedResources: []string{"unleash_feature.search_legacy", "unleash_feature.search_ranking"},
+			expectedImports:   []string{"unleash_feature.search_ranking"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			tfWriter := &bytes.Buffer{}
+			importWriter := &bytes.Buffer{}
+
+			err := generator.Generate(client, "default", tfWriter, importWriter, testCase.options...)
+			require.NoError(tt, err)
+
+			assert.Equal(tt, testCase.expectedResources, findAddresses(`resource "(\w+)" "(\w+)"`, tfWriter.String()))
+			assert.Equal(tt, testCase.expectedImports, findAddresses(`to =(\w+)\.(\w+)`, importWriter.String()))
+		})
+	}
+
+	err = generator.Generate(client, "default", &bytes.Buffer{}, &bytes.Buffer{}, generator.WithIncludedFeatures("["))
+	assert.ErrorContains(t, err, "invalid feature name pattern")
+
+	// tag types and strategies are not fetched when they are skipped
+	server.InjectFault(inmem.Fault{PathPrefix: "/api/admin/tag-types", StatusCode: 500})
+	server.InjectFault(inmem.Fault{PathPrefix: "/api/admin/strategies", StatusCode: 500})
+	err = generator.Generate(client, "default", &bytes.Buffer{}, &bytes.Buffer{}, generator.WithoutTagTypes(), generator.WithoutStrategies())
+	assert.NoError(t, err)
+}
+
+func TestGenerateSegmentReferences(t *testing.T) {
+	server := inmem.CreateTestServer()
+	port := server.Start(t)
+
+	client, err := unleash.CreateClient("http://localhost:"+strconv.Itoa(port), "any")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for _, segment := range []unleash.CreateSegmentJSONRequestBody{
+		{Name: "QA", Constraints: []unleash.ConstraintSchema{}},
+		{Name: "Other", Project: ptr.ToPtr("other"), Constraints: []unleash.ConstraintSchema{}},
+	} {
+		_, _ = server.CreateSegment(ctx, unleash.CreateSegmentRequestObject{
+			Body: &segment,
+		})
+	}
+	_, _ = server.CreateFeature(ctx, unleash.CreateFeatureRequestObject{
+		ProjectId: "default",
+		Body: &unleash.CreateFeatureJSONRequestBody{
+			Name: "test.feature",
+			Type: ptr.ToPtr("release"),
+		},
+	})
+	_, _ = server.AddFeatureStrategy(ctx, unleash.AddFeatureStrategyRequestObject{
+		ProjectId:   "default",
+		FeatureName: "test.feature",
+		Environment: "development",
+		Body: &unleash.AddFeatureStrategyJSONRequestBody{
+			Name:     "default",
+			Segments: ptr.ToPtr([]float32{2, 3}),
+		},
+	})
+
+	var testCases = []struct {
+		name             string
+		options          []generator.Option
+		expectedSegments string
+	}{
+		{
+			name:             "generated segments",
+			expectedSegments: "segments = [unleash_segment.other.id_int, 3]",
+		},
+		{
+			name:             "without segments",
+			options:          []generator.Option{generator.WithoutSegments()},
+			expectedSegments: "segments = [2, 3]",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			tfWriter := &bytes.Buffer{}
+
+			err := generator.Generate(client, "default", tfWriter, &bytes.Buffer{}, testCase.options...)
+			require.NoError(tt, err)
+
+			assert.Contains(tt, convertMultipleSpacesToSingleSpace(tfWriter.String()), testCase.expectedSegments)
+		})
+	}
+}
+
+func TestGenerateSegmentImportByName(t *testing.T) {
+	server := inmem.CreateTestServer()
+	port := server.Start(t)
+
+	client, err := unleash.CreateClient("http://localhost:"+strconv.Itoa(port), "any")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for _, segment := range []unleash.CreateSegmentJSONRequestBody{
+		{Name: "QA", Constraints: []unleash.ConstraintSchema{}},
+		{Name: "QA", Project: ptr.ToPtr("beta"), Constraints: []unleash.ConstraintSchema{}},
+		{Name: "Beta", Constraints: []unleash.ConstraintSchema{}},
+	} {
+		_, _ = server.CreateSegment(ctx, unleash.CreateSegmentRequestObject{
+			Body: &segment,
+		})
+	}
+
+	generated, err := generator.GenerateProjects(client, []string{"beta"}, generator.WithSegmentImportByName())
+	require.NoError(t, err)
+
+	var importIDs []string
+	for _, files := range generated {
+		for _, match := range regexp.MustCompile(`id = "(.+)"`).FindAllStringSubmatch(string(files.ImportTf), -1) {
+			importIDs = append(importIDs, match[1])
+		}
+	}
+	// the global QA segment is imported by ID since its name is also used by a project segment
+	assert.Equal(t, []string{"1", "name:Beta", "beta/QA"}, importIDs)
+}
+
+func findAddresses(pattern string, tf string) []string {
+	var addresses []string
+	for _, match := range regexp.MustCompile(pattern).FindAllStringSubmatch(tf, -1) {
+		addresses = append(addresses, match[1]+"."+match[2])
+	}
+	return addresses
+}
+
+func TestGenerateModule(t *testing.T) {
+	server := inmem.CreateTestServer()
+	port := server.Start(t)
+
+	client, err := unleash.CreateClient("http://localhost:"+strconv.Itoa(port), "any")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for featureName, featureType := range map[string]string{
+		"payment.checkout": "release",
+		"payment.refund":   "experiment",
+		"search.ranking":   "release",
+	} {
+		_, _ = server.CreateFeature(ctx, unleash.CreateFeatureRequestObject{
+			ProjectId: "default",
+			Body: &unleash.CreateFeatureJSONRequestBody{
+				Name: featureName,
+				Type: ptr.ToPtr(featureType),
+			},
+		})
+	}
+	_, _ = server.CreateTagType(ctx, unleash.CreateTagTypeRequestObject{
+		Body: &unleash.CreateTagTypeJSONRequestBody{
+			Name: "team",
+		},
+	})
+	for featureName, team := range map[string]string{"payment.checkout": "Payments", "payment.refund": "Payments"} {
+		_, _ = server.AddTag(ctx, unleash.AddTagRequestObject{
+			FeatureName: featureName,
+			Body: &unleash.AddTagJSONRequestBody{
+				Type:  "team",
+				Value: team,
+			},
+		})
+	}
+	_, _ = server.CreateSegment(ctx, unleash.CreateSegmentRequestObject{
+		Body: &unleash.CreateSegmentJSONRequestBody{
+			Name:        "QA",
+			Constraints: []unleash.ConstraintSchema{},
+		},
+	})
+
+	var testCases = []struct {
+		name              string
+		groupBy           string
+		expectedPaths     []string
+		expectedMainTf    string
+		expectedImportsTf string
+	}{
+		{
+			name: "file per feature",
+			expectedPaths: []string{
+				"imports.tf",
+				"segments.tf",
+				"tag_types.tf",
+				"unleash_feature.payment_checkout.tf",
+				"unleash_feature.payment_refund.tf",
+				"unleash_feature.search_ranking.tf",
+			},
+			expectedImportsTf: `import {
+  to =unleash_segment.qa
+  id = "1"
+}
+
+import {
+  to =unleash_tag_type.team
+  id = "team"
+}
+
+import {
+  to =unleash_feature.payment_checkout
+  id = "default.payment.checkout"
+}
+
+import {
+  to =unleash_feature.payment_refund
+  id = "default.payment.refund"
+}
+
+import {
+  to =unleash_feature.search_ranking
+  id = "default.search.ranking"
+}`,
+		},
+		{
+			name:    "group by type",
+			groupBy: generator.GroupByType,
+			expectedPaths: []string{
+				"imports.tf",
+				"main.tf",
+				"modules/experiment/unleash_feature.payment_refund.tf",
+				"modules/experiment/versions.tf",
+				"modules/release/unleash_feature.payment_checkout.tf",
+				"modules/release/unleash_feature.search_ranking.tf",
+				"modules/release/versions.tf",
+				"segments.tf",
+				"tag_types.tf",
+			},
+			expectedMainTf: `module "experiment" {
+  source = "./modules/experiment"
+}
+
+module "release" {
+  source = "./modules/release"
+}`,
+		},
+		{
+			name:    "group by tag",
+			groupBy: generator.GroupByTagPrefix + "team",
+			expectedPaths: []string{
+				"imports.tf",
+				"main.tf",
+				"modules/payments/unleash_feature.payment_checkout.tf",
+				"modules/payments/unleash_feature.payment_refund.tf",
+				"modules/payments/versions.tf",
+				"segments.tf",
+				"tag_types.tf",
+				"unleash_feature.search_ranking.tf",
+			},
+			expectedMainTf: `module "payments" {
+  source = "./modules/payments"
+}`,
+			expectedImportsTf: `import {
+  to =unleash_segment.qa
+  id = "1"
+}
+
+import {
+  to =unleash_tag_type.team
+  id = "team"
+}
+
+import {
+  to =unleash_feature.search_ranking
+  id = "default.search.ranking"
+}
+
+import {
+  to =module.payments.unleash_feature.payment_checkout
+  id = "default.payment.checkout"
+}
+
+import {
+  to =module.payments.unleash_feature.payment_refund
+  id = "default.payment.refund"
+}`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(tt *testing.T) {
+			files, err := generator.GenerateModule(client, []string{"default"}, generator.WithGroupBy(testCase.groupBy))
+			require.NoError(tt, err)
+
+			contentsByPath := make(map[string]string)
+			paths := make([]string, 0, len(files))
+			for _, file := range files {
+				paths = append(paths, file.Path)
+				contentsByPath[file.Path] = string(file.Content)
+			}
+			assert.Equal(tt, testCase.expectedPaths, paths)
+			if testCase.expectedMainTf != "" {
+				assertTfEqual(tt, testCase.expectedMainTf, contentsByPath["main.tf"])
+			}
+			if testCase.expectedImportsTf != "" {
+				assertTfEqual(tt, testCase.expectedImportsTf, contentsByPath["imports.tf"])
+			}
+		})
+	}
+
+	_, err = generator.GenerateModule(client, []string{"default"}, generator.WithGroupBy("owner"))
+	assert.ErrorContains(t, err, "invalid group by")
+}
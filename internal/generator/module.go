@@ -0,0 +1,228 @@
+package generator
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+const (
+	// GroupByType groups features by their type.
+	GroupByType = "type"
+	// GroupByProject groups features by their project.
+	GroupByProject = "project"
+	// GroupByTagPrefix groups features by the value of their tag of the type following the prefix, e.g. `tag:team`.
+	// Features without such tag are not grouped.
+	GroupByTagPrefix = "tag:"
+)
+
+const providerSource = "LINEMANWongnai/unleash"
+
+// File is a file of a generated Terraform module.
+type File struct {
+	// Path is relative to the root module directory.
+	Path    string
+	Content []byte
+}
+
+type childModule struct {
+	name     string
+	features []unleash.FetchedFeature
+}
+
+// GenerateModule generates the projects, or all projects if no project is given, as a Terraform root module
+// where each feature is generated in its own unleash_feature.<name>.tf file. Segments, tag types and custom strategies
+// are generated in segments.tf, tag_types.tf and strategies.tf while all import blocks are generated in imports.tf
+// of the root module.
+// Features are generated in child modules under modules/ when they are grouped by WithGroupBy.
+func GenerateModule(client unleash.ClientWithResponsesInterface, projectIDs []string, opts ...Option) ([]File, error) {
+	g, err := newGenerator(client, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(projectIDs) == 0 {
+		projectIDs, err = g.getProjectIDs()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var files []File
+	importHclFile := hclwrite.NewEmptyFile()
+
+	segments, err := g.getSegments()
+	if err != nil {
+		return nil, err
+	}
+	segments = filterSegments(segments, projectIDs)
+	g.nameSegments(segments)
+	segmentsHclFile := hclwrite.NewEmptyFile()
+	err = g.genSegments(segments, segmentsHclFile.Body(), importHclFile.Body())
+	if err != nil {
+		return nil, err
+	}
+	files = appendFile(files, "segments.tf", segmentsHclFile)
+
+	tagTypesHclFile := hclwrite.NewEmptyFile()
+	err = g.genTagTypes(tagTypesHclFile.Body(), importHclFile.Body())
+	if err != nil {
+		return nil, err
+	}
+	files = appendFile(files, "tag_types.tf", tagTypesHclFile)
+
+	strategiesHclFile := hclwrite.NewEmptyFile()
+	err = g.genStrategies(strategiesHclFile.Body(), importHclFile.Body())
+	if err != nil {
+		return nil, err
+	}
+	files = appendFile(files, "strategies.tf", strategiesHclFile)
+
+	var fetchedFeatures []unleash.FetchedFeature
+	for _, projectID := range projectIDs {
+		projectFeatures, err := g.getFeatures(projectID)
+		if err != nil {
+			return nil, err
+		}
+		fetchedFeatures = append(fetchedFeatures, projectFeatures...)
+	}
+	sort.Sort(byFeatureName(fetchedFeatures))
+
+	modules := g.groupFeatures(fetchedFeatures)
+	mainHclFile := hclwrite.NewEmptyFile()
+	for _, module := range modules {
+		dir := ""
+		moduleAddress := ""
+		if module.name != "" {
+			dir = path.Join("modules", module.name)
+			moduleAddress = "module." + module.name + "."
+			moduleBody := mainHclFile.Body().AppendNewBlock("module", []string{module.name}).Body()
+			moduleBody.SetAttributeValue("source", cty.StringVal("./"+dir))
+			mainHclFile.Body().AppendNewline()
+			files = appendFile(files, path.Join(dir, "versions.tf"), createVersionsFile())
+		}
+		for _, fetchedFeature := range module.features {
+			featureHclFile := hclwrite.NewEmptyFile()
+			resourceName, err := g.genFeature(fetchedFeature, moduleAddress, featureHclFile.Body(), importHclFile.Body())
+			if err != nil {
+				return nil, err
+			}
+			files = appendFile(files, path.Join(dir, "unleash_feature."+resourceName+".tf"), featureHclFile)
+		}
+	}
+	files = appendFile(files, "main.tf", mainHclFile)
+	files = appendFile(files, "imports.tf", importHclFile)
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+
+	return files, nil
+}
+
+// groupFeatures groups the features into child modules. Features which are not grouped are in the module without name,
+// which is the root module.
+func (g *generator) groupFeatures(fetchedFeatures []unleash.FetchedFeature) []childModule {
+	moduleNamesByGroup := make(map[string]string)
+	var modules []childModule
+	moduleIndexes := make(map[string]int)
+	for _, fetchedFeature := range fetchedFeatures {
+		group := g.featureGroup(fetchedFeature)
+		moduleName := ""
+		if group != "" {
+			var ok bool
+			moduleName, ok = moduleNamesByGroup[group]
+			if !ok {
+				moduleName = g.resourceName("module", strings.ToLower(group))
+				moduleNamesByGroup[group] = moduleName
+			}
+		}
+		i, ok := moduleIndexes[moduleName]
+		if !ok {
+			i = len(modules)
+			moduleIndexes[moduleName] = i
+			modules = append(modules, childModule{name: moduleName})
+		}
+		modules[i].features = append(modules[i].features, fetchedFeature)
+	}
+	sort.Slice(modules, func(i, j int) bool {
+		return modules[i].name < modules[j].name
+	})
+
+	return modules
+}
+
+func (g *generator) featureGroup(fetchedFeature unleash.FetchedFeature) string {
+	switch {
+	case g.options.groupBy == GroupByType:
+		if fetchedFeature.Feature.Type != nil {
+			return *fetchedFeature.Feature.Type
+		}
+	case g.options.groupBy == GroupByProject:
+		return fetchedFeature.FetchedProject
+	case strings.HasPrefix(g.options.groupBy, GroupByTagPrefix):
+		if fetchedFeature.Feature.Tags == nil {
+			return ""
+		}
+		tagType := strings.TrimPrefix(g.options.groupBy, GroupByTagPrefix)
+		var values []string
+		for _, tag := range *fetchedFeature.Feature.Tags {
+			if tag.Type == tagType {
+				values = append(values, tag.Value)
+			}
+		}
+		// the first value is used when a feature has multiple tags of the type
+		if len(values) > 0 {
+			sort.Strings(values)
+			return values[0]
+		}
+	}
+
+	return ""
+}
+
+// filterSegments keeps global segments and segments of the projects.
+func filterSegments(segments []unleash.AdminSegmentSchema, projectIDs []string) []unleash.AdminSegmentSchema {
+	var filtered []unleash.AdminSegmentSchema
+	for _, segment := range segments {
+		if segment.Project == nil || *segment.Project == "" {
+			filtered = append(filtered, segment)
+			continue
+		}
+		for _, projectID := range projectIDs {
+			if *segment.Project == projectID {
+				filtered = append(filtered, segment)
+				break
+			}
+		}
+	}
+
+	return filtered
+}
+
+func createVersionsFile() *hclwrite.File {
+	hclFile := hclwrite.NewEmptyFile()
+	providersBody := hclFile.Body().AppendNewBlock("terraform", nil).Body().AppendNewBlock("required_providers", nil).Body()
+	providersBody.SetAttributeValue("unleash", cty.ObjectVal(map[string]cty.Value{
+		"source": cty.StringVal(providerSource),
+	}))
+
+	return hclFile
+}
+
+// appendFile appends the file unless it is empty.
+func appendFile(files []File, filePath string, hclFile *hclwrite.File) []File {
+	content := hclFile.Bytes()
+	if len(strings.TrimSpace(string(content))) == 0 {
+		return files
+	}
+
+	return append(files, File{
+		Path:    filePath,
+		Content: content,
+	})
+}
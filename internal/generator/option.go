@@ -0,0 +1,144 @@
+package generator
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+type options struct {
+	includedFeatures []string
+	excludedFeatures []string
+	featureTypes     []string
+	archived         bool
+	skipSegments     bool
+	skipTagTypes     bool
+	skipStrategies   bool
+	concurrency      int
+	groupBy          string
+	// segmentImportByName imports segments by name rather than ID
+	segmentImportByName bool
+}
+
+// Option customizes what is generated.
+type Option func(options *options)
+
+// WithIncludedFeatures only generates features whose name matches one of the glob patterns, e.g. `payment.*`.
+func WithIncludedFeatures(patterns ...string) Option {
+	return func(options *options) {
+		options.includedFeatures = append(options.includedFeatures, patterns...)
+	}
+}
+
+// WithExcludedFeatures skips features whose name matches one of the glob patterns.
+func WithExcludedFeatures(patterns ...string) Option {
+	return func(options *options) {
+		options.excludedFeatures = append(options.excludedFeatures, patterns...)
+	}
+}
+
+// WithFeatureTypes only generates features of the types, e.g. `release`.
+func WithFeatureTypes(featureTypes ...string) Option {
+	return func(options *options) {
+		options.featureTypes = append(options.featureTypes, featureTypes...)
+	}
+}
+
+// WithArchivedFeatures also generates archived features. They have no import block since
+// archived features cannot be imported, so applying them revives the features instead.
+func WithArchivedFeatures() Option {
+	return func(options *options) {
+		options.archived = true
+	}
+}
+
+// WithoutSegments skips generating segments.
+func WithoutSegments() Option {
+	return func(options *options) {
+		options.skipSegments = true
+	}
+}
+
+// WithoutTagTypes skips generating tag types.
+func WithoutTagTypes() Option {
+	return func(options *options) {
+		options.skipTagTypes = true
+	}
+}
+
+// WithoutStrategies skips generating custom strategies.
+func WithoutStrategies() Option {
+	return func(options *options) {
+		options.skipStrategies = true
+	}
+}
+
+// WithSegmentImportByName generates import blocks of segments with `<project>/<name>` or `name:<name>` IDs,
+// which do not depend on the segment IDs of an Unleash instance. Segments with ambiguous names are still imported by ID.
+func WithSegmentImportByName() Option {
+	return func(options *options) {
+		options.segmentImportByName = true
+	}
+}
+
+// WithConcurrency limits the number of features which are fetched concurrently.
+func WithConcurrency(concurrency int) Option {
+	return func(options *options) {
+		options.concurrency = concurrency
+	}
+}
+
+// WithGroupBy groups features into child modules by GroupByType, GroupByProject or GroupByTagPrefix followed by a tag type.
+// It is only used by GenerateModule.
+func WithGroupBy(groupBy string) Option {
+	return func(options *options) {
+		options.groupBy = groupBy
+	}
+}
+
+func toOptions(opts []Option) (options, error) {
+	o := options{
+		concurrency: unleash.DefaultFetchConcurrency,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	for _, pattern := range slices.Concat(o.includedFeatures, o.excludedFeatures) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return o, fmt.Errorf("invalid feature name pattern %q: %w", pattern, err)
+		}
+	}
+	switch {
+	case o.groupBy == "", o.groupBy == GroupByType, o.groupBy == GroupByProject:
+	case strings.HasPrefix(o.groupBy, GroupByTagPrefix) && len(o.groupBy) > len(GroupByTagPrefix):
+	default:
+		return o, fmt.Errorf("invalid group by %q, expected %q, %q or %q followed by a tag type", o.groupBy, GroupByType, GroupByProject, GroupByTagPrefix)
+	}
+
+	return o, nil
+}
+
+func (o options) isFeatureIncluded(feature unleash.FeatureSchema) bool {
+	if len(o.featureTypes) > 0 && (feature.Type == nil || !slices.Contains(o.featureTypes, *feature.Type)) {
+		return false
+	}
+	if len(o.includedFeatures) > 0 && !matchAny(o.includedFeatures, feature.Name) {
+		return false
+	}
+
+	return !matchAny(o.excludedFeatures, feature.Name)
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		// patterns are validated by toOptions
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+
+	return false
+}
@@ -7,6 +7,8 @@ var variantType cty.Type
 var variantOverrideType cty.Type
 var constraintType cty.Type
 var strategyVariantType cty.Type
+var dependencyType cty.Type
+var tagType cty.Type
 
 func init() {
 	environmentType = createEnvironmentType()
@@ -14,6 +16,8 @@ func init() {
 	variantOverrideType = createVariantOverrideType()
 	constraintType = createConstraintType()
 	strategyVariantType = createStrategyVariantType()
+	dependencyType = createDependencyType()
+	tagType = createTagType()
 }
 
 func createEnvironmentType() cty.Type {
@@ -77,3 +81,18 @@ func createStrategyVariantType() cty.Type {
 		"stickiness":   cty.String,
 	})
 }
+
+func createDependencyType() cty.Type {
+	return cty.Object(map[string]cty.Type{
+		"feature":  cty.String,
+		"enabled":  cty.Bool,
+		"variants": cty.Set(cty.String),
+	})
+}
+
+func createTagType() cty.Type {
+	return cty.Object(map[string]cty.Type{
+		"type":  cty.String,
+		"value": cty.String,
+	})
+}
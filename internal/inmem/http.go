@@ -13,7 +13,7 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func startHTTPServer(t *testing.T, register func(engine *gin.Engine) error) int {
+func startHTTPServer(t testing.TB, register func(engine *gin.Engine) error) int {
 	addr := "localhost:0"
 	listener, err := net.Listen("tcp", addr)
 	if err != nil {
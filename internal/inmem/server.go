@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
+	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -20,26 +22,79 @@ import (
 var _ unleash.StrictServerInterface = &TestServer{}
 
 type TestServer struct {
-	features map[string]map[string]unleash.FeatureSchema
-	segments map[string]unleash.AdminSegmentSchema
-	lock     *sync.RWMutex
-	next     *atomic.Int32
+	features                 map[string]map[string]unleash.FeatureSchema
+	segments                 map[string]unleash.AdminSegmentSchema
+	projects                 map[string]unleash.ProjectCreatedSchema
+	projectDefaultStrategies map[string]map[string]unleash.CreateFeatureStrategySchema
+	contextFields            map[string]unleash.ContextFieldSchema
+	environments             map[string]unleash.EnvironmentSchema
+	apiTokens                map[string]unleash.ApiTokenSchema
+	tagTypes                 map[string]unleash.TagTypeSchema
+	strategies               map[string]unleash.StrategySchema
+	addons                   map[string]unleash.AddonSchema
+	users                    map[string]unleash.UserSchema
+	pats                     map[string]unleash.PatSchema
+	publicSignupTokens       map[string]unleash.PublicSignupTokenSchema
+	lock                     *sync.RWMutex
+	next                     *atomic.Int32
+	faults                   *faultInjector
 }
 
 func CreateTestServer() *TestServer {
 	return &TestServer{
 		features: make(map[string]map[string]unleash.FeatureSchema),
 		segments: make(map[string]unleash.AdminSegmentSchema),
-		lock:     &sync.RWMutex{},
-		next:     &atomic.Int32{},
+		projects: map[string]unleash.ProjectCreatedSchema{
+			"default": {
+				Id:                "default",
+				Name:              "Default",
+				Description:       ptr.ToPtr("Default project"),
+				Mode:              ptr.ToPtr(unleash.ProjectCreatedSchemaModeOpen),
+				DefaultStickiness: ptr.ToPtr("default"),
+				Environments:      ptr.ToPtr([]string{"development", "production"}),
+			},
+		},
+		projectDefaultStrategies: make(map[string]map[string]unleash.CreateFeatureStrategySchema),
+		contextFields:            make(map[string]unleash.ContextFieldSchema),
+		environments: map[string]unleash.EnvironmentSchema{
+			"development": {
+				Name:      "development",
+				Type:      "development",
+				Enabled:   true,
+				SortOrder: 100,
+			},
+			"production": {
+				Name:      "production",
+				Type:      "production",
+				Enabled:   true,
+				SortOrder: 200,
+			},
+		},
+		apiTokens: make(map[string]unleash.ApiTokenSchema),
+		tagTypes: map[string]unleash.TagTypeSchema{
+			"simple": {
+				Name:        "simple",
+				Description: ptr.ToPtr("Used to simplify filtering of features"),
+				Icon:        ptr.ToPtr("#"),
+			},
+		},
+		strategies:         createBuiltInStrategies(),
+		addons:             make(map[string]unleash.AddonSchema),
+		users:              make(map[string]unleash.UserSchema),
+		pats:               make(map[string]unleash.PatSchema),
+		publicSignupTokens: make(map[string]unleash.PublicSignupTokenSchema),
+		lock:               &sync.RWMutex{},
+		next:               &atomic.Int32{},
+		faults:             &faultInjector{},
 	}
 }
 
-func (t TestServer) Start(tt *testing.T) int {
+func (t TestServer) Start(tt testing.TB) int {
 	return startHTTPServer(tt, t.register)
 }
 
 func (t TestServer) register(engine *gin.Engine) error {
+	engine.Use(t.faults.handle)
 	unleash.RegisterHandlers(engine, unleash.NewStrictHandler(t, nil))
 	return nil
 }
@@ -82,6 +137,9 @@ func (t TestServer) getFeatures(projectID string) []unleash.FeatureSchema {
 	for _, f := range projectFeatures {
 		features = append(features, f)
 	}
+	sort.Slice(features, func(i, j int) bool {
+		return features[i].Name < features[j].Name
+	})
 
 	return features
 }
@@ -95,17 +153,15 @@ func (t TestServer) replaceFeature(feature unleash.FeatureSchema) {
 	projectFeatures[feature.Name] = feature
 }
 
-func (t TestServer) deleteFeature(projectID string, featureName string) bool {
+func (t TestServer) deleteFeature(featureName string) bool {
 	t.lock.Lock()
 	defer t.lock.Unlock()
 
-	projectFeatures := t.getProjectFeaturesNoLock(projectID)
-
-	_, ok := projectFeatures[featureName]
+	feature, ok := t.findFeatureNoLock(featureName)
 	if !ok {
 		return false
 	}
-	delete(projectFeatures, featureName)
+	delete(t.features[*feature.Project], featureName)
 
 	return true
 }
@@ -117,9 +173,12 @@ func (t TestServer) getNext(name string) string {
 }
 
 func (t TestServer) CreateFeature(_ context.Context, request unleash.CreateFeatureRequestObject) (unleash.CreateFeatureResponseObject, error) {
-	_, ok := t.getFeature(request.ProjectId, request.Body.Name)
+	t.lock.RLock()
+	_, ok := t.findFeatureNoLock(request.Body.Name)
+	t.lock.RUnlock()
 	if ok {
-		return unleash.CreateFeature404JSONResponse{}, nil
+		// feature names are unique across projects including archived features
+		return CreateFeature409JSONResponse{}, nil
 	}
 	projectID := request.ProjectId
 	environments := []unleash.FeatureEnvironmentSchema{
@@ -167,15 +226,31 @@ func removeProperties(feature unleash.FeatureSchema) unleash.FeatureSchema {
 }
 
 func (t TestServer) GetFeatures(_ context.Context, request unleash.GetFeaturesRequestObject) (unleash.GetFeaturesResponseObject, error) {
-	features := t.getFeatures(request.ProjectId)
-	for i, feature := range features {
-		features[i] = removeProperties(feature)
+	features := make([]unleash.FeatureSchema, 0)
+	for _, feature := range t.getFeatures(request.ProjectId) {
+		// the real server lists archived features separately
+		if feature.Archived == nil || !*feature.Archived {
+			features = append(features, removeProperties(feature))
+		}
 	}
 	return unleash.GetFeatures200JSONResponse{
 		Features: features,
 	}, nil
 }
 
+func (t TestServer) GetArchivedFeaturesByProjectId(_ context.Context, request unleash.GetArchivedFeaturesByProjectIdRequestObject) (unleash.GetArchivedFeaturesByProjectIdResponseObject, error) {
+	features := make([]unleash.FeatureSchema, 0)
+	for _, feature := range t.getFeatures(request.ProjectId) {
+		if feature.Archived != nil && *feature.Archived {
+			features = append(features, removeProperties(feature))
+		}
+	}
+	return unleash.GetArchivedFeaturesByProjectId200JSONResponse{
+		Version:  1,
+		Features: features,
+	}, nil
+}
+
 func (t TestServer) UpdateFeature(_ context.Context, request unleash.UpdateFeatureRequestObject) (unleash.UpdateFeatureResponseObject, error) {
 	feature, ok := t.getFeature(request.ProjectId, request.FeatureName)
 	if !ok {
@@ -201,7 +276,7 @@ func (t TestServer) ArchiveFeature(_ context.Context, request unleash.ArchiveFea
 }
 
 func (t TestServer) DeleteFeature(_ context.Context, request unleash.DeleteFeatureRequestObject) (unleash.DeleteFeatureResponseObject, error) {
-	ok := t.deleteFeature("default", request.FeatureName)
+	ok := t.deleteFeature(request.FeatureName)
 	if !ok {
 		return unleash.DeleteFeature403JSONResponse{}, nil
 	}
@@ -209,6 +284,38 @@ func (t TestServer) DeleteFeature(_ context.Context, request unleash.DeleteFeatu
 	return unleash.DeleteFeature200Response{}, nil
 }
 
+func (t TestServer) ReviveFeature(_ context.Context, request unleash.ReviveFeatureRequestObject) (unleash.ReviveFeatureResponseObject, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	feature, ok := t.findFeatureNoLock(request.FeatureName)
+	if !ok || feature.Archived == nil || !*feature.Archived {
+		return ReviveFeature404JSONResponse{}, nil
+	}
+	feature.Archived = ptr.ToPtr(false)
+	t.features[*feature.Project][feature.Name] = feature
+
+	return unleash.ReviveFeature200Response{}, nil
+}
+
+func (t TestServer) StaleFeatures(_ context.Context, request unleash.StaleFeaturesRequestObject) (unleash.StaleFeaturesResponseObject, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	projectFeatures := t.getProjectFeaturesNoLock(request.ProjectId)
+	for _, featureName := range request.Body.Features {
+		feature, ok := projectFeatures[featureName]
+		if !ok {
+			// the real server ignores features which do not exist
+			continue
+		}
+		feature.Stale = ptr.ToPtr(request.Body.Stale)
+		projectFeatures[featureName] = feature
+	}
+
+	return unleash.StaleFeatures202Response{}, nil
+}
+
 func (t TestServer) ToggleFeatureEnvironmentOn(_ context.Context, request unleash.ToggleFeatureEnvironmentOnRequestObject) (unleash.ToggleFeatureEnvironmentOnResponseObject, error) {
 	feature, ok := t.getFeature(request.ProjectId, request.FeatureName)
 	if !ok {
@@ -334,6 +441,24 @@ func (t TestServer) GetFeatureStrategies(_ context.Context, request unleash.GetF
 	})), nil
 }
 
+func (t TestServer) GetFeatureStrategy(_ context.Context, request unleash.GetFeatureStrategyRequestObject) (unleash.GetFeatureStrategyResponseObject, error) {
+	feature, ok := t.getFeature(request.ProjectId, request.FeatureName)
+	if !ok {
+		return unleash.GetFeatureStrategy404JSONResponse{}, nil
+	}
+	environment, ok := getEnvironment(request.Environment, *feature.Environments)
+	if !ok || environment.Strategies == nil {
+		return unleash.GetFeatureStrategy404JSONResponse{}, nil
+	}
+	for _, strategy := range *environment.Strategies {
+		if *strategy.Id == request.StrategyId {
+			return unleash.GetFeatureStrategy200JSONResponse(strategy), nil
+		}
+	}
+
+	return unleash.GetFeatureStrategy404JSONResponse{}, nil
+}
+
 func getEnvironment(environmentName string, environments []unleash.FeatureEnvironmentSchema) (unleash.FeatureEnvironmentSchema, bool) {
 	for _, environment := range environments {
 		if environment.Name == environmentName {
@@ -443,7 +568,7 @@ func (t TestServer) SetStrategySortOrder(_ context.Context, request unleash.SetS
 			strategies = *environment.Strategies
 		}
 		for i := range strategies {
-			strategy := strategies[i]
+			strategy := &strategies[i]
 			for _, sortOrderWithID := range *request.Body {
 				if *strategy.Id == sortOrderWithID.Id {
 					strategy.SortOrder = ptr.ToPtr(sortOrderWithID.SortOrder)
@@ -623,6 +748,42 @@ func (response OverwriteFeatureVariantsOnEnvironments413JSONResponse) VisitOverw
 	return json.NewEncoder(w).Encode(response)
 }
 
+type CreateFeature409JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response CreateFeature409JSONResponse) VisitCreateFeatureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReviveFeature404JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response ReviveFeature404JSONResponse) VisitReviveFeatureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 func (t TestServer) getSegment(id string) (unleash.AdminSegmentSchema, bool) {
 	t.lock.RLock()
 	defer t.lock.RUnlock()
@@ -651,10 +812,16 @@ func (t TestServer) deleteSegment(id string) bool {
 	return true
 }
 func (t TestServer) GetSegments(_ context.Context, request unleash.GetSegmentsRequestObject) (unleash.GetSegmentsResponseObject, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
 	segments := make([]unleash.AdminSegmentSchema, 0, len(t.segments))
 	for _, segment := range t.segments {
 		segments = append(segments, segment)
 	}
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].Id < segments[j].Id
+	})
 	return unleash.GetSegments200JSONResponse{
 		Segments: &segments,
 	}, nil
@@ -677,12 +844,80 @@ func (t TestServer) CreateSegment(_ context.Context, request unleash.CreateSegme
 }
 
 func (t TestServer) RemoveSegment(_ context.Context, request unleash.RemoveSegmentRequestObject) (unleash.RemoveSegmentResponseObject, error) {
+	// Unleash rejects deleting segments which are still used by strategies
+	if len(t.getSegmentStrategies(request.Id).Strategies) > 0 {
+		return unleash.RemoveSegment409JSONResponse{
+			Message: ptr.ToPtr(fmt.Sprintf("Segment %s is still used by strategies", request.Id)),
+		}, nil
+	}
 	if !t.deleteSegment(request.Id) {
 		return unleash.RemoveSegment409JSONResponse{}, nil
 	}
 	return unleash.RemoveSegment204Response{}, nil
 }
 
+func (t TestServer) GetStrategiesBySegmentId(_ context.Context, request unleash.GetStrategiesBySegmentIdRequestObject) (unleash.GetStrategiesBySegmentIdResponseObject, error) {
+	return unleash.GetStrategiesBySegmentId200JSONResponse(t.getSegmentStrategies(request.Id)), nil
+}
+
+// getSegmentStrategies returns the strategies which use the segment sorted by project, feature and environment.
+func (t TestServer) getSegmentStrategies(id string) unleash.SegmentStrategiesSchema {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	segmentStrategies := unleash.SegmentStrategiesSchema{}
+	segmentID, err := strconv.Atoi(id)
+	if err != nil {
+		return segmentStrategies
+	}
+	for projectID, projectFeatures := range t.features {
+		for _, feature := range projectFeatures {
+			if feature.Environments == nil {
+				continue
+			}
+			for _, environment := range *feature.Environments {
+				if environment.Strategies == nil {
+					continue
+				}
+				for _, strategy := range *environment.Strategies {
+					if strategy.Segments == nil || !slices.Contains(*strategy.Segments, float32(segmentID)) {
+						continue
+					}
+					segmentStrategies.Strategies = append(segmentStrategies.Strategies, struct {
+						Environment  string `json:"environment"`
+						FeatureName  string `json:"featureName"`
+						Id           string `json:"id"`
+						ProjectId    string `json:"projectId"`
+						StrategyName string `json:"strategyName"`
+					}{
+						Environment:  environment.Name,
+						FeatureName:  feature.Name,
+						Id:           *strategy.Id,
+						ProjectId:    projectID,
+						StrategyName: strategy.Name,
+					})
+				}
+			}
+		}
+	}
+	strategies := segmentStrategies.Strategies
+	sort.Slice(strategies, func(i, j int) bool {
+		a, b := strategies[i], strategies[j]
+		if a.ProjectId != b.ProjectId {
+			return a.ProjectId < b.ProjectId
+		}
+		if a.FeatureName != b.FeatureName {
+			return a.FeatureName < b.FeatureName
+		}
+		if a.Environment != b.Environment {
+			return a.Environment < b.Environment
+		}
+		return a.Id < b.Id
+	})
+
+	return segmentStrategies
+}
+
 func (t TestServer) GetSegment(_ context.Context, request unleash.GetSegmentRequestObject) (unleash.GetSegmentResponseObject, error) {
 	segment, found := t.getSegment(request.Id)
 	if !found {
@@ -0,0 +1,247 @@
+package inmem
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+// maskedAddonParameter replaces the values of sensitive parameters in responses like Unleash does.
+const maskedAddonParameter = "*****"
+
+// addonProviders are a subset of the addon providers of Unleash with their parameters.
+var addonProviders = []unleash.AddonTypeSchema{
+	{
+		Name:        "webhook",
+		DisplayName: "Webhook",
+		Parameters: &[]unleash.AddonParameterSchema{
+			{Name: "url", DisplayName: "Webhook URL", Type: "url", Required: true},
+			{Name: "contentType", DisplayName: "Content-Type", Type: "text"},
+			{Name: "authorization", DisplayName: "Authorization", Type: "text", Sensitive: true},
+			{Name: "bodyTemplate", DisplayName: "Body template", Type: "textfield"},
+			{Name: "customHeaders", DisplayName: "Extra HTTP Headers", Type: "textfield", Sensitive: true},
+		},
+	},
+	{
+		Name:        "slack",
+		DisplayName: "Slack",
+		Parameters: &[]unleash.AddonParameterSchema{
+			{Name: "url", DisplayName: "Slack webhook URL", Type: "url", Required: true, Sensitive: true},
+			{Name: "username", DisplayName: "Username", Type: "text"},
+			{Name: "emojiIcon", DisplayName: "Emoji Icon", Type: "text"},
+			{Name: "defaultChannel", DisplayName: "Default channel", Type: "text"},
+			{Name: "customHeaders", DisplayName: "Extra HTTP Headers", Type: "textfield", Sensitive: true},
+		},
+	},
+	{
+		Name:        "teams",
+		DisplayName: "Microsoft Teams",
+		Parameters: &[]unleash.AddonParameterSchema{
+			{Name: "url", DisplayName: "Microsoft Teams webhook URL", Type: "url", Required: true, Sensitive: true},
+			{Name: "customHeaders", DisplayName: "Extra HTTP Headers", Type: "textfield", Sensitive: true},
+		},
+	},
+	{
+		Name:        "datadog",
+		DisplayName: "Datadog",
+		Parameters: &[]unleash.AddonParameterSchema{
+			{Name: "url", DisplayName: "Datadog Events URL", Type: "url"},
+			{Name: "apiKey", DisplayName: "Datadog API key", Type: "text", Required: true, Sensitive: true},
+			{Name: "sourceTypeName", DisplayName: "Datadog Source Type Name", Type: "text"},
+			{Name: "customHeaders", DisplayName: "Extra HTTP Headers", Type: "textfield", Sensitive: true},
+			{Name: "bodyTemplate", DisplayName: "Body template", Type: "textfield"},
+		},
+	},
+}
+
+func getAddonProvider(name string) (unleash.AddonTypeSchema, bool) {
+	for _, provider := range addonProviders {
+		if provider.Name == name {
+			return provider, true
+		}
+	}
+
+	return unleash.AddonTypeSchema{}, false
+}
+
+// validateAddon returns the reason why Unleash would reject the addon or an empty string.
+func validateAddon(addon unleash.AddonCreateUpdateSchema) string {
+	provider, ok := getAddonProvider(addon.Provider)
+	if !ok {
+		return fmt.Sprintf("Addon provider %s is not supported", addon.Provider)
+	}
+	for _, parameter := range *provider.Parameters {
+		if value, ok := addon.Parameters[parameter.Name]; parameter.Required && (!ok || value == "") {
+			return fmt.Sprintf("Parameter %s of addon provider %s is required", parameter.Name, addon.Provider)
+		}
+	}
+
+	return ""
+}
+
+// maskAddon hides the values of the sensitive parameters of an addon.
+func maskAddon(addon unleash.AddonSchema) unleash.AddonSchema {
+	provider, ok := getAddonProvider(addon.Provider)
+	if !ok {
+		return addon
+	}
+	parameters := make(map[string]interface{}, len(addon.Parameters))
+	for name, value := range addon.Parameters {
+		parameters[name] = value
+	}
+	for _, parameter := range *provider.Parameters {
+		if _, ok := parameters[parameter.Name]; ok && parameter.Sensitive {
+			parameters[parameter.Name] = maskedAddonParameter
+		}
+	}
+	addon.Parameters = parameters
+
+	return addon
+}
+
+func toAddon(id int, body unleash.AddonCreateUpdateSchema) unleash.AddonSchema {
+	addon := unleash.AddonSchema{
+		Id:           id,
+		Provider:     body.Provider,
+		Description:  body.Description,
+		Enabled:      body.Enabled,
+		Events:       body.Events,
+		Parameters:   body.Parameters,
+		Projects:     body.Projects,
+		Environments: body.Environments,
+	}
+	// Unleash responds empty lists rather than nulls
+	if addon.Events == nil {
+		addon.Events = []string{}
+	}
+	if addon.Parameters == nil {
+		addon.Parameters = map[string]interface{}{}
+	}
+	if addon.Projects == nil {
+		addon.Projects = &[]string{}
+	}
+	if addon.Environments == nil {
+		addon.Environments = &[]string{}
+	}
+
+	return addon
+}
+
+func (t TestServer) getAddon(id string) (unleash.AddonSchema, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	addon, ok := t.addons[id]
+	return addon, ok
+}
+
+func (t TestServer) replaceAddon(addon unleash.AddonSchema) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.addons[strconv.Itoa(addon.Id)] = addon
+}
+
+func (t TestServer) deleteAddon(id string) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	_, ok := t.addons[id]
+	if !ok {
+		return false
+	}
+	delete(t.addons, id)
+
+	return true
+}
+
+func (t TestServer) GetAddons(_ context.Context, _ unleash.GetAddonsRequestObject) (unleash.GetAddonsResponseObject, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	addons := make([]unleash.AddonSchema, 0, len(t.addons))
+	for _, addon := range t.addons {
+		addons = append(addons, maskAddon(addon))
+	}
+	sort.Slice(addons, func(i, j int) bool {
+		return addons[i].Id < addons[j].Id
+	})
+
+	return unleash.GetAddons200JSONResponse{
+		Addons:    addons,
+		Providers: addonProviders,
+	}, nil
+}
+
+func (t TestServer) CreateAddon(_ context.Context, request unleash.CreateAddonRequestObject) (unleash.CreateAddonResponseObject, error) {
+	if reason := validateAddon(*request.Body); reason != "" {
+		return unleash.CreateAddon400JSONResponse{
+			Message: ptr.ToPtr(reason),
+		}, nil
+	}
+	addon := toAddon(int(t.next.Add(1)), *request.Body)
+	t.replaceAddon(addon)
+
+	return unleash.CreateAddon200JSONResponse(maskAddon(addon)), nil
+}
+
+func (t TestServer) GetAddon(_ context.Context, request unleash.GetAddonRequestObject) (unleash.GetAddonResponseObject, error) {
+	addon, ok := t.getAddon(request.Id)
+	if !ok {
+		return GetAddon404JSONResponse{}, nil
+	}
+
+	return unleash.GetAddon200JSONResponse(maskAddon(addon)), nil
+}
+
+func (t TestServer) UpdateAddon(_ context.Context, request unleash.UpdateAddonRequestObject) (unleash.UpdateAddonResponseObject, error) {
+	existingAddon, ok := t.getAddon(request.Id)
+	if !ok {
+		return unleash.UpdateAddon404JSONResponse{}, nil
+	}
+	if reason := validateAddon(*request.Body); reason != "" {
+		return unleash.UpdateAddon400JSONResponse{
+			Message: ptr.ToPtr(reason),
+		}, nil
+	}
+	addon := toAddon(existingAddon.Id, *request.Body)
+	// Unleash keeps the existing description if it is not given
+	if addon.Description == nil {
+		addon.Description = existingAddon.Description
+	}
+	t.replaceAddon(addon)
+
+	return unleash.UpdateAddon200JSONResponse(maskAddon(addon)), nil
+}
+
+func (t TestServer) DeleteAddon(_ context.Context, request unleash.DeleteAddonRequestObject) (unleash.DeleteAddonResponseObject, error) {
+	if !t.deleteAddon(request.Id) {
+		return unleash.DeleteAddon404JSONResponse{}, nil
+	}
+
+	return unleash.DeleteAddon200Response{}, nil
+}
+
+type GetAddon404JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response GetAddon404JSONResponse) VisitGetAddonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
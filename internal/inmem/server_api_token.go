@@ -0,0 +1,181 @@
+package inmem
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+func (t TestServer) getApiToken(secret string) (unleash.ApiTokenSchema, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	apiToken, ok := t.apiTokens[secret]
+	return apiToken, ok
+}
+
+func (t TestServer) replaceApiToken(apiToken unleash.ApiTokenSchema) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.apiTokens[apiToken.Secret] = apiToken
+}
+
+func (t TestServer) deleteApiToken(secret string) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	_, ok := t.apiTokens[secret]
+	if !ok {
+		return false
+	}
+	delete(t.apiTokens, secret)
+
+	return true
+}
+
+func (t TestServer) listApiTokens(filter func(apiToken unleash.ApiTokenSchema) bool) []unleash.ApiTokenSchema {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	apiTokens := make([]unleash.ApiTokenSchema, 0, len(t.apiTokens))
+	for _, apiToken := range t.apiTokens {
+		if filter(apiToken) {
+			apiTokens = append(apiTokens, apiToken)
+		}
+	}
+	sort.Slice(apiTokens, func(i, j int) bool {
+		return apiTokens[i].Secret < apiTokens[j].Secret
+	})
+
+	return apiTokens
+}
+
+func (t TestServer) GetAllApiTokens(_ context.Context, _ unleash.GetAllApiTokensRequestObject) (unleash.GetAllApiTokensResponseObject, error) {
+	return unleash.GetAllApiTokens200JSONResponse{
+		Tokens: t.listApiTokens(func(_ unleash.ApiTokenSchema) bool {
+			return true
+		}),
+	}, nil
+}
+
+func (t TestServer) GetApiTokensByName(_ context.Context, request unleash.GetApiTokensByNameRequestObject) (unleash.GetApiTokensByNameResponseObject, error) {
+	return unleash.GetApiTokensByName200JSONResponse{
+		Tokens: t.listApiTokens(func(apiToken unleash.ApiTokenSchema) bool {
+			return apiToken.TokenName == request.Name
+		}),
+	}, nil
+}
+
+func (t TestServer) CreateApiToken(_ context.Context, request unleash.CreateApiTokenRequestObject) (unleash.CreateApiTokenResponseObject, error) {
+	body, err := request.Body.AsCreateApiTokenSchema2()
+	if err != nil {
+		return nil, err
+	}
+	tokenType := unleash.ApiTokenSchemaType(strings.ToLower(body.Type))
+	if body.TokenName == "" || body.Project != nil && body.Projects != nil {
+		return CreateApiToken400JSONResponse{}, nil
+	}
+	apiToken := unleash.ApiTokenSchema{
+		TokenName: body.TokenName,
+		Type:      tokenType,
+		ExpiresAt: body.ExpiresAt,
+		CreatedAt: time.Now(),
+	}
+	switch tokenType {
+	case unleash.ApiTokenSchemaTypeAdmin:
+		if body.Environment != nil || body.Project != nil || body.Projects != nil {
+			return CreateApiToken400JSONResponse{}, nil
+		}
+		apiToken.Environment = ptr.ToPtr("*")
+		apiToken.Projects = []string{"*"}
+	case unleash.ApiTokenSchemaTypeClient, unleash.ApiTokenSchemaTypeFrontend:
+		apiToken.Environment = ptr.ToPtr("default")
+		if body.Environment != nil {
+			apiToken.Environment = body.Environment
+		}
+		apiToken.Projects = []string{"*"}
+		if body.Project != nil {
+			apiToken.Projects = []string{*body.Project}
+		}
+		if body.Projects != nil && len(*body.Projects) > 0 {
+			apiToken.Projects = slices.Clone(*body.Projects)
+		}
+	default:
+		return CreateApiToken400JSONResponse{}, nil
+	}
+	apiToken.Project = "[]"
+	if len(apiToken.Projects) == 1 {
+		apiToken.Project = apiToken.Projects[0]
+	}
+	apiToken.Secret = apiToken.Project + ":" + *apiToken.Environment + "." + t.getNext("secret")
+	t.replaceApiToken(apiToken)
+
+	return unleash.CreateApiToken201JSONResponse{
+		Body: apiToken,
+		Headers: unleash.CreateApiToken201ResponseHeaders{
+			Location: "api-tokens/" + apiToken.Secret,
+		},
+	}, nil
+}
+
+func (t TestServer) UpdateApiToken(_ context.Context, request unleash.UpdateApiTokenRequestObject) (unleash.UpdateApiTokenResponseObject, error) {
+	apiToken, ok := t.getApiToken(request.Token)
+	if !ok {
+		return UpdateApiToken404JSONResponse{}, nil
+	}
+	apiToken.ExpiresAt = ptr.ToPtr(request.Body.ExpiresAt)
+	t.replaceApiToken(apiToken)
+
+	return unleash.UpdateApiToken200Response{}, nil
+}
+
+func (t TestServer) DeleteApiToken(_ context.Context, request unleash.DeleteApiTokenRequestObject) (unleash.DeleteApiTokenResponseObject, error) {
+	// Unleash responds OK even if the token does not exist
+	t.deleteApiToken(request.Token)
+
+	return unleash.DeleteApiToken200Response{}, nil
+}
+
+type CreateApiToken400JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response CreateApiToken400JSONResponse) VisitCreateApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateApiToken404JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response UpdateApiToken404JSONResponse) VisitUpdateApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,191 @@
+package inmem
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+func (t TestServer) getContextField(name string) (unleash.ContextFieldSchema, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	contextField, ok := t.contextFields[name]
+	return contextField, ok
+}
+
+func (t TestServer) replaceContextField(contextField unleash.ContextFieldSchema) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.contextFields[contextField.Name] = contextField
+}
+
+func (t TestServer) deleteContextField(name string) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	_, ok := t.contextFields[name]
+	if !ok {
+		return false
+	}
+	delete(t.contextFields, name)
+
+	return true
+}
+
+func (t TestServer) GetContextFields(_ context.Context, _ unleash.GetContextFieldsRequestObject) (unleash.GetContextFieldsResponseObject, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	contextFields := make([]unleash.ContextFieldSchema, 0, len(t.contextFields))
+	for _, contextField := range t.contextFields {
+		contextFields = append(contextFields, contextField)
+	}
+	sort.Slice(contextFields, func(i, j int) bool {
+		return contextFields[i].Name < contextFields[j].Name
+	})
+
+	return unleash.GetContextFields200JSONResponse(contextFields), nil
+}
+
+func (t TestServer) CreateContextField(_ context.Context, request unleash.CreateContextFieldRequestObject) (unleash.CreateContextFieldResponseObject, error) {
+	_, ok := t.getContextField(request.Body.Name)
+	if ok {
+		return CreateContextField409JSONResponse{}, nil
+	}
+	contextField := unleash.ContextFieldSchema{
+		Name:        request.Body.Name,
+		Description: request.Body.Description,
+		LegalValues: request.Body.LegalValues,
+		SortOrder:   ptr.ToPtr(10),
+		Stickiness:  ptr.ToPtr(false),
+		CreatedAt:   ptr.ToPtr(time.Now()),
+	}
+	if request.Body.SortOrder != nil {
+		contextField.SortOrder = request.Body.SortOrder
+	}
+	if request.Body.Stickiness != nil {
+		contextField.Stickiness = request.Body.Stickiness
+	}
+	t.replaceContextField(contextField)
+
+	return unleash.CreateContextField201JSONResponse{
+		Body: contextField,
+		Headers: unleash.CreateContextField201ResponseHeaders{
+			Location: "context/" + contextField.Name,
+		},
+	}, nil
+}
+
+func (t TestServer) GetContextField(_ context.Context, request unleash.GetContextFieldRequestObject) (unleash.GetContextFieldResponseObject, error) {
+	contextField, ok := t.getContextField(request.ContextField)
+	if !ok {
+		return GetContextField404JSONResponse{}, nil
+	}
+
+	return unleash.GetContextField200JSONResponse(contextField), nil
+}
+
+func (t TestServer) UpdateContextField(_ context.Context, request unleash.UpdateContextFieldRequestObject) (unleash.UpdateContextFieldResponseObject, error) {
+	contextField, ok := t.getContextField(request.ContextField)
+	if !ok {
+		return UpdateContextField404JSONResponse{}, nil
+	}
+	contextField.Description = request.Body.Description
+	contextField.LegalValues = request.Body.LegalValues
+	if request.Body.SortOrder != nil {
+		contextField.SortOrder = request.Body.SortOrder
+	}
+	if request.Body.Stickiness != nil {
+		contextField.Stickiness = request.Body.Stickiness
+	}
+	t.replaceContextField(contextField)
+
+	return unleash.UpdateContextField200Response{}, nil
+}
+
+func (t TestServer) DeleteContextField(_ context.Context, request unleash.DeleteContextFieldRequestObject) (unleash.DeleteContextFieldResponseObject, error) {
+	if !t.deleteContextField(request.ContextField) {
+		return DeleteContextField404JSONResponse{}, nil
+	}
+
+	return unleash.DeleteContextField200Response{}, nil
+}
+
+type CreateContextField409JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response CreateContextField409JSONResponse) VisitCreateContextFieldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContextField404JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response GetContextField404JSONResponse) VisitGetContextFieldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateContextField404JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response UpdateContextField404JSONResponse) VisitUpdateContextFieldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteContextField404JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response DeleteContextField404JSONResponse) VisitDeleteContextFieldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
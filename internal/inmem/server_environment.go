@@ -0,0 +1,160 @@
+package inmem
+
+import (
+	"context"
+	"sort"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+func (t TestServer) getEnvironment(name string) (unleash.EnvironmentSchema, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	environment, ok := t.environments[name]
+	return environment, ok
+}
+
+func (t TestServer) replaceEnvironment(environment unleash.EnvironmentSchema) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.environments[environment.Name] = environment
+}
+
+func (t TestServer) deleteEnvironment(name string) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	_, ok := t.environments[name]
+	if !ok {
+		return false
+	}
+	delete(t.environments, name)
+
+	return true
+}
+
+func (t TestServer) GetAllEnvironments(_ context.Context, _ unleash.GetAllEnvironmentsRequestObject) (unleash.GetAllEnvironmentsResponseObject, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	environments := make([]unleash.EnvironmentSchema, 0, len(t.environments))
+	for _, environment := range t.environments {
+		environments = append(environments, environment)
+	}
+	sort.Slice(environments, func(i, j int) bool {
+		if environments[i].SortOrder != environments[j].SortOrder {
+			return environments[i].SortOrder < environments[j].SortOrder
+		}
+		return environments[i].Name < environments[j].Name
+	})
+
+	return unleash.GetAllEnvironments200JSONResponse{
+		Version:      1,
+		Environments: environments,
+	}, nil
+}
+
+func (t TestServer) GetEnvironment(_ context.Context, request unleash.GetEnvironmentRequestObject) (unleash.GetEnvironmentResponseObject, error) {
+	environment, ok := t.getEnvironment(request.Name)
+	if !ok {
+		return unleash.GetEnvironment404JSONResponse{}, nil
+	}
+
+	return unleash.GetEnvironment200JSONResponse(environment), nil
+}
+
+func (t TestServer) CreateEnvironment(_ context.Context, request unleash.CreateEnvironmentRequestObject) (unleash.CreateEnvironmentResponseObject, error) {
+	_, ok := t.getEnvironment(request.Body.Name)
+	if ok {
+		return unleash.CreateEnvironment409JSONResponse{}, nil
+	}
+	environment := unleash.EnvironmentSchema{
+		Name:      request.Body.Name,
+		Type:      request.Body.Type,
+		Enabled:   true,
+		SortOrder: 9999,
+	}
+	if request.Body.Enabled != nil {
+		environment.Enabled = *request.Body.Enabled
+	}
+	if request.Body.SortOrder != nil {
+		environment.SortOrder = *request.Body.SortOrder
+	}
+	t.replaceEnvironment(environment)
+
+	return unleash.CreateEnvironment201JSONResponse(environment), nil
+}
+
+func (t TestServer) UpdateEnvironment(_ context.Context, request unleash.UpdateEnvironmentRequestObject) (unleash.UpdateEnvironmentResponseObject, error) {
+	environment, ok := t.getEnvironment(request.Name)
+	if !ok {
+		return unleash.UpdateEnvironment404JSONResponse{}, nil
+	}
+	if environment.Protected {
+		return unleash.UpdateEnvironment400JSONResponse{}, nil
+	}
+	if request.Body.Type != nil {
+		environment.Type = *request.Body.Type
+	}
+	if request.Body.SortOrder != nil {
+		environment.SortOrder = *request.Body.SortOrder
+	}
+	t.replaceEnvironment(environment)
+
+	return unleash.UpdateEnvironment200JSONResponse(environment), nil
+}
+
+func (t TestServer) RemoveEnvironment(_ context.Context, request unleash.RemoveEnvironmentRequestObject) (unleash.RemoveEnvironmentResponseObject, error) {
+	environment, ok := t.getEnvironment(request.Name)
+	if !ok {
+		return unleash.RemoveEnvironment404JSONResponse{}, nil
+	}
+	if environment.Protected {
+		return unleash.RemoveEnvironment400JSONResponse{}, nil
+	}
+	t.deleteEnvironment(request.Name)
+
+	return unleash.RemoveEnvironment200Response{}, nil
+}
+
+func (t TestServer) UpdateSortOrder(_ context.Context, request unleash.UpdateSortOrderRequestObject) (unleash.UpdateSortOrderResponseObject, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for name := range *request.Body {
+		if _, ok := t.environments[name]; !ok {
+			return unleash.UpdateSortOrder404JSONResponse{}, nil
+		}
+	}
+	for name, sortOrder := range *request.Body {
+		environment := t.environments[name]
+		environment.SortOrder = sortOrder
+		t.environments[name] = environment
+	}
+
+	return unleash.UpdateSortOrder200Response{}, nil
+}
+
+func (t TestServer) ToggleEnvironmentOn(_ context.Context, request unleash.ToggleEnvironmentOnRequestObject) (unleash.ToggleEnvironmentOnResponseObject, error) {
+	environment, ok := t.getEnvironment(request.Name)
+	if !ok {
+		return unleash.ToggleEnvironmentOn404JSONResponse{}, nil
+	}
+	environment.Enabled = true
+	t.replaceEnvironment(environment)
+
+	return unleash.ToggleEnvironmentOn204Response{}, nil
+}
+
+func (t TestServer) ToggleEnvironmentOff(_ context.Context, request unleash.ToggleEnvironmentOffRequestObject) (unleash.ToggleEnvironmentOffResponseObject, error) {
+	environment, ok := t.getEnvironment(request.Name)
+	if !ok {
+		return unleash.ToggleEnvironmentOff404JSONResponse{}, nil
+	}
+	environment.Enabled = false
+	t.replaceEnvironment(environment)
+
+	return unleash.ToggleEnvironmentOff204Response{}, nil
+}
@@ -0,0 +1,127 @@
+package inmem
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Fault describes an error response which is returned instead of handling a matched request.
+type Fault struct {
+	// Method limits the fault to requests with this method. Requests with any method are matched if it is empty.
+	Method string
+	// PathPrefix limits the fault to requests with the path prefix. Requests with any path are matched if it is empty.
+	PathPrefix string
+	// StatusCode is the status code of the injected response.
+	StatusCode int
+	// RetryAfter is set as Retry-After header of the injected response if it is not empty.
+	RetryAfter string
+	// Every injects the fault to every n-th matched request instead of all matched requests if it is greater than 1.
+	Every int
+	// Times limits how many times the fault is injected. It is unlimited if it is 0.
+	Times int
+}
+
+type injectedFault struct {
+	Fault
+	matched  int
+	injected int
+}
+
+type faultInjector struct {
+	lock     sync.Mutex
+	faults   []*injectedFault
+	injected int
+	latency  time.Duration
+}
+
+// InjectFault makes the server respond with the fault to the matched requests. Faults are checked in the order they are injected.
+func (t TestServer) InjectFault(fault Fault) {
+	t.faults.lock.Lock()
+	defer t.faults.lock.Unlock()
+
+	t.faults.faults = append(t.faults.faults, &injectedFault{Fault: fault})
+}
+
+// ClearFaults removes all injected faults.
+func (t TestServer) ClearFaults() {
+	t.faults.lock.Lock()
+	defer t.faults.lock.Unlock()
+
+	t.faults.faults = nil
+}
+
+// InjectedFaults returns the number of responses which have been replaced by a fault.
+func (t TestServer) InjectedFaults() int {
+	t.faults.lock.Lock()
+	defer t.faults.lock.Unlock()
+
+	return t.faults.injected
+}
+
+// SetLatency delays every response by the latency to simulate a remote server.
+func (t TestServer) SetLatency(latency time.Duration) {
+	t.faults.lock.Lock()
+	defer t.faults.lock.Unlock()
+
+	t.faults.latency = latency
+}
+
+func (f *faultInjector) getLatency() time.Duration {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.latency
+}
+
+func (f *faultInjector) next(method string, path string) (Fault, bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	for _, fault := range f.faults {
+		if fault.Method != "" && fault.Method != method {
+			continue
+		}
+		if !strings.HasPrefix(path, fault.PathPrefix) {
+			continue
+		}
+		if fault.Times > 0 && fault.injected >= fault.Times {
+			continue
+		}
+		fault.matched++
+		if fault.Every > 1 && fault.matched%fault.Every != 0 {
+			continue
+		}
+		fault.injected++
+		f.injected++
+
+		return fault.Fault, true
+	}
+
+	return Fault{}, false
+}
+
+func (f *faultInjector) handle(c *gin.Context) {
+	if latency := f.getLatency(); latency > 0 {
+		select {
+		case <-c.Request.Context().Done():
+		case <-time.After(latency):
+		}
+	}
+
+	fault, ok := f.next(c.Request.Method, c.Request.URL.Path)
+	if !ok {
+		c.Next()
+		return
+	}
+	if fault.RetryAfter != "" {
+		c.Header("Retry-After", fault.RetryAfter)
+	}
+	c.AbortWithStatusJSON(fault.StatusCode, gin.H{
+		"name":    "InjectedFault",
+		"message": http.StatusText(fault.StatusCode),
+	})
+}
@@ -0,0 +1,121 @@
+package inmem
+
+import (
+	"context"
+	"slices"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+// featureDependency is identical to the anonymous element type of unleash.FeatureSchema.Dependencies.
+type featureDependency = struct {
+	Enabled  *bool     `json:"enabled,omitempty"`
+	Feature  string    `json:"feature"`
+	Variants *[]string `json:"variants,omitempty"`
+}
+
+func (t TestServer) AddFeatureDependency(_ context.Context, request unleash.AddFeatureDependencyRequestObject) (unleash.AddFeatureDependencyResponseObject, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	projectFeatures := t.getProjectFeaturesNoLock(request.ProjectId)
+	child, ok := projectFeatures[request.FeatureName]
+	if !ok {
+		return unleash.AddFeatureDependency404JSONResponse{}, nil
+	}
+	parent, ok := projectFeatures[request.Body.Feature]
+	if !ok {
+		return unleash.AddFeatureDependency404JSONResponse{}, nil
+	}
+
+	dependency := featureDependency{
+		Enabled:  request.Body.Enabled,
+		Feature:  request.Body.Feature,
+		Variants: request.Body.Variants,
+	}
+	var dependencies []featureDependency
+	if child.Dependencies != nil {
+		dependencies = slices.Clone(*child.Dependencies)
+	}
+	index := slices.IndexFunc(dependencies, func(d featureDependency) bool {
+		return d.Feature == dependency.Feature
+	})
+	if index < 0 {
+		dependencies = append(dependencies, dependency)
+	} else {
+		dependencies[index] = dependency
+	}
+	child.Dependencies = &dependencies
+	projectFeatures[child.Name] = child
+
+	var children []string
+	if parent.Children != nil {
+		children = slices.Clone(*parent.Children)
+	}
+	if !slices.Contains(children, child.Name) {
+		children = append(children, child.Name)
+	}
+	parent.Children = &children
+	projectFeatures[parent.Name] = parent
+
+	return unleash.AddFeatureDependency200Response{}, nil
+}
+
+func (t TestServer) DeleteFeatureDependency(_ context.Context, request unleash.DeleteFeatureDependencyRequestObject) (unleash.DeleteFeatureDependencyResponseObject, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	projectFeatures := t.getProjectFeaturesNoLock(request.ProjectId)
+	child, ok := projectFeatures[request.FeatureName]
+	if !ok {
+		return unleash.DeleteFeatureDependency404JSONResponse{}, nil
+	}
+	removeDependency(projectFeatures, child, request.Parent)
+
+	return unleash.DeleteFeatureDependency200Response{}, nil
+}
+
+func (t TestServer) DeleteFeatureDependencies(_ context.Context, request unleash.DeleteFeatureDependenciesRequestObject) (unleash.DeleteFeatureDependenciesResponseObject, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	projectFeatures := t.getProjectFeaturesNoLock(request.ProjectId)
+	child, ok := projectFeatures[request.FeatureName]
+	if !ok {
+		return unleash.DeleteFeatureDependencies404JSONResponse{}, nil
+	}
+	if child.Dependencies != nil {
+		for _, dependency := range *child.Dependencies {
+			child = removeDependency(projectFeatures, child, dependency.Feature)
+		}
+	}
+
+	return unleash.DeleteFeatureDependencies200Response{}, nil
+}
+
+func removeDependency(projectFeatures map[string]unleash.FeatureSchema, child unleash.FeatureSchema, parentName string) unleash.FeatureSchema {
+	if child.Dependencies != nil {
+		dependencies := slices.DeleteFunc(slices.Clone(*child.Dependencies), func(d featureDependency) bool {
+			return d.Feature == parentName
+		})
+		child.Dependencies = &dependencies
+		if len(dependencies) == 0 {
+			child.Dependencies = nil
+		}
+		projectFeatures[child.Name] = child
+	}
+
+	parent, ok := projectFeatures[parentName]
+	if ok && parent.Children != nil {
+		children := slices.DeleteFunc(slices.Clone(*parent.Children), func(name string) bool {
+			return name == child.Name
+		})
+		parent.Children = &children
+		if len(children) == 0 {
+			parent.Children = nil
+		}
+		projectFeatures[parent.Name] = parent
+	}
+
+	return child
+}
@@ -0,0 +1,225 @@
+package inmem
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+var defaultProjectEnvironments = []string{"development", "production"}
+
+func (t TestServer) getProject(id string) (unleash.ProjectCreatedSchema, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	project, ok := t.projects[id]
+	return project, ok
+}
+
+func (t TestServer) replaceProject(project unleash.ProjectCreatedSchema) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.projects[project.Id] = project
+}
+
+func (t TestServer) deleteProject(id string) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	_, ok := t.projects[id]
+	if !ok {
+		return false
+	}
+	delete(t.projects, id)
+	delete(t.projectDefaultStrategies, id)
+
+	return true
+}
+
+func (t TestServer) getProjectDefaultStrategy(projectID string, environment string) (unleash.CreateFeatureStrategySchema, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	strategy, ok := t.projectDefaultStrategies[projectID][environment]
+	return strategy, ok
+}
+
+func (t TestServer) replaceProjectDefaultStrategy(projectID string, environment string, strategy unleash.CreateFeatureStrategySchema) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	strategies, ok := t.projectDefaultStrategies[projectID]
+	if !ok {
+		strategies = make(map[string]unleash.CreateFeatureStrategySchema)
+		t.projectDefaultStrategies[projectID] = strategies
+	}
+	strategies[environment] = strategy
+}
+
+func (t TestServer) deleteProjectDefaultStrategy(projectID string, environment string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.projectDefaultStrategies[projectID], environment)
+}
+
+func (t TestServer) GetProjects(_ context.Context, _ unleash.GetProjectsRequestObject) (unleash.GetProjectsResponseObject, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	projects := make([]unleash.ProjectSchema, 0, len(t.projects))
+	for _, project := range t.projects {
+		projectSchema := unleash.ProjectSchema{
+			Id:                project.Id,
+			Name:              project.Name,
+			Description:       project.Description,
+			DefaultStickiness: project.DefaultStickiness,
+		}
+		if project.Mode != nil {
+			projectSchema.Mode = ptr.ToPtr(unleash.ProjectSchemaMode(*project.Mode))
+		}
+		projects = append(projects, projectSchema)
+	}
+	sort.Slice(projects, func(i, j int) bool {
+		return projects[i].Id < projects[j].Id
+	})
+
+	return unleash.GetProjects200JSONResponse{
+		Version:  1,
+		Projects: projects,
+	}, nil
+}
+
+func (t TestServer) CreateProject(_ context.Context, request unleash.CreateProjectRequestObject) (unleash.CreateProjectResponseObject, error) {
+	_, ok := t.getProject(request.Body.Id)
+	if ok {
+		return unleash.CreateProject409JSONResponse{}, nil
+	}
+	project := unleash.ProjectCreatedSchema{
+		Id:                request.Body.Id,
+		Name:              request.Body.Name,
+		Description:       request.Body.Description,
+		Mode:              ptr.ToPtr(unleash.ProjectCreatedSchemaModeOpen),
+		DefaultStickiness: ptr.ToPtr("default"),
+		Environments:      ptr.ToPtr(slices.Clone(defaultProjectEnvironments)),
+	}
+	if request.Body.Mode != nil {
+		project.Mode = ptr.ToPtr(unleash.ProjectCreatedSchemaMode(*request.Body.Mode))
+	}
+	if request.Body.DefaultStickiness != nil {
+		project.DefaultStickiness = request.Body.DefaultStickiness
+	}
+	if request.Body.Environments != nil {
+		project.Environments = ptr.ToPtr(slices.Clone(*request.Body.Environments))
+	}
+	t.replaceProject(project)
+
+	return unleash.CreateProject201JSONResponse(project), nil
+}
+
+func (t TestServer) UpdateProject(_ context.Context, request unleash.UpdateProjectRequestObject) (unleash.UpdateProjectResponseObject, error) {
+	project, ok := t.getProject(request.ProjectId)
+	if !ok {
+		return unleash.UpdateProject404JSONResponse{}, nil
+	}
+	project.Name = request.Body.Name
+	project.Description = request.Body.Description
+	if request.Body.Mode != nil {
+		project.Mode = ptr.ToPtr(unleash.ProjectCreatedSchemaMode(*request.Body.Mode))
+	}
+	if request.Body.DefaultStickiness != nil {
+		project.DefaultStickiness = request.Body.DefaultStickiness
+	}
+	t.replaceProject(project)
+
+	return unleash.UpdateProject200Response{}, nil
+}
+
+func (t TestServer) DeleteProject(_ context.Context, request unleash.DeleteProjectRequestObject) (unleash.DeleteProjectResponseObject, error) {
+	if !t.deleteProject(request.ProjectId) {
+		return unleash.DeleteProject404JSONResponse{}, nil
+	}
+
+	return unleash.DeleteProject200Response{}, nil
+}
+
+func (t TestServer) GetProjectOverview(_ context.Context, request unleash.GetProjectOverviewRequestObject) (unleash.GetProjectOverviewResponseObject, error) {
+	project, ok := t.getProject(request.ProjectId)
+	if !ok {
+		return unleash.GetProjectOverview404JSONResponse{}, nil
+	}
+	overview := unleash.ProjectOverviewSchema{
+		Version:           1,
+		Name:              project.Name,
+		Description:       project.Description,
+		DefaultStickiness: project.DefaultStickiness,
+	}
+	if project.Mode != nil {
+		overview.Mode = ptr.ToPtr(unleash.ProjectOverviewSchemaMode(*project.Mode))
+	}
+	environments := make([]unleash.ProjectEnvironmentSchema, 0)
+	if project.Environments != nil {
+		for _, environment := range *project.Environments {
+			projectEnvironment := unleash.ProjectEnvironmentSchema{
+				Environment: environment,
+			}
+			if strategy, ok := t.getProjectDefaultStrategy(project.Id, environment); ok {
+				projectEnvironment.DefaultStrategy = &strategy
+			}
+			environments = append(environments, projectEnvironment)
+		}
+	}
+	overview.Environments = &environments
+
+	return unleash.GetProjectOverview200JSONResponse(overview), nil
+}
+
+func (t TestServer) AddEnvironmentToProject(_ context.Context, request unleash.AddEnvironmentToProjectRequestObject) (unleash.AddEnvironmentToProjectResponseObject, error) {
+	project, ok := t.getProject(request.ProjectId)
+	if !ok {
+		return unleash.AddEnvironmentToProject409JSONResponse{}, nil
+	}
+	var environments []string
+	if project.Environments != nil {
+		environments = *project.Environments
+	}
+	if slices.Contains(environments, request.Body.Environment) {
+		return unleash.AddEnvironmentToProject409JSONResponse{}, nil
+	}
+	environments = append(slices.Clone(environments), request.Body.Environment)
+	project.Environments = &environments
+	t.replaceProject(project)
+
+	return unleash.AddEnvironmentToProject200Response{}, nil
+}
+
+func (t TestServer) RemoveEnvironmentFromProject(_ context.Context, request unleash.RemoveEnvironmentFromProjectRequestObject) (unleash.RemoveEnvironmentFromProjectResponseObject, error) {
+	project, ok := t.getProject(request.ProjectId)
+	if !ok || project.Environments == nil {
+		return unleash.RemoveEnvironmentFromProject400JSONResponse{}, nil
+	}
+	index := slices.Index(*project.Environments, request.Environment)
+	if index < 0 {
+		return unleash.RemoveEnvironmentFromProject400JSONResponse{}, nil
+	}
+	environments := slices.Delete(slices.Clone(*project.Environments), index, index+1)
+	project.Environments = &environments
+	t.replaceProject(project)
+	t.deleteProjectDefaultStrategy(project.Id, request.Environment)
+
+	return unleash.RemoveEnvironmentFromProject200Response{}, nil
+}
+
+func (t TestServer) AddDefaultStrategyToProjectEnvironment(_ context.Context, request unleash.AddDefaultStrategyToProjectEnvironmentRequestObject) (unleash.AddDefaultStrategyToProjectEnvironmentResponseObject, error) {
+	project, ok := t.getProject(request.ProjectId)
+	if !ok || project.Environments == nil || !slices.Contains(*project.Environments, request.Environment) {
+		return unleash.AddDefaultStrategyToProjectEnvironment400JSONResponse{}, nil
+	}
+	t.replaceProjectDefaultStrategy(project.Id, request.Environment, *request.Body)
+
+	return unleash.AddDefaultStrategyToProjectEnvironment200JSONResponse(*request.Body), nil
+}
@@ -0,0 +1,217 @@
+package inmem
+
+import (
+	"context"
+	"sort"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+type strategyParameter = struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Required    *bool   `json:"required,omitempty"`
+	Type        *string `json:"type,omitempty"`
+}
+
+// createBuiltInStrategies creates the strategies bundled with Unleash, which cannot be edited.
+func createBuiltInStrategies() map[string]unleash.StrategySchema {
+	strategies := []unleash.StrategySchema{
+		{
+			Name:        "default",
+			Title:       ptr.ToPtr("Standard"),
+			Description: ptr.ToPtr("The standard strategy is strictly on / off for your entire userbase."),
+			Parameters:  []strategyParameter{},
+		},
+		{
+			Name:        "flexibleRollout",
+			Title:       ptr.ToPtr("Gradual rollout"),
+			Description: ptr.ToPtr("Roll out to a percentage of your userbase, and ensure that the experience is the same for the user on each visit."),
+			Parameters: []strategyParameter{
+				{Name: ptr.ToPtr("rollout"), Type: ptr.ToPtr("percentage"), Required: ptr.ToPtr(false)},
+				{Name: ptr.ToPtr("stickiness"), Type: ptr.ToPtr("string"), Required: ptr.ToPtr(true)},
+				{Name: ptr.ToPtr("groupId"), Type: ptr.ToPtr("string"), Required: ptr.ToPtr(true)},
+			},
+		},
+		{
+			Name:        "userWithId",
+			Title:       ptr.ToPtr("UserIDs"),
+			Description: ptr.ToPtr("Enable the feature for a specific set of userIds."),
+			Parameters: []strategyParameter{
+				{Name: ptr.ToPtr("userIds"), Type: ptr.ToPtr("list"), Required: ptr.ToPtr(false)},
+			},
+		},
+		{
+			Name:        "remoteAddress",
+			Title:       ptr.ToPtr("IPs"),
+			Description: ptr.ToPtr("Enable the feature for a specific set of IP addresses."),
+			Parameters: []strategyParameter{
+				{Name: ptr.ToPtr("IPs"), Type: ptr.ToPtr("list"), Required: ptr.ToPtr(true)},
+			},
+		},
+		{
+			Name:        "applicationHostname",
+			Title:       ptr.ToPtr("Hosts"),
+			Description: ptr.ToPtr("Enable the feature for a specific set of hostnames."),
+			Parameters: []strategyParameter{
+				{Name: ptr.ToPtr("hostNames"), Type: ptr.ToPtr("list"), Required: ptr.ToPtr(false)},
+			},
+		},
+	}
+
+	strategiesByName := make(map[string]unleash.StrategySchema, len(strategies))
+	for _, strategy := range strategies {
+		strategy.DisplayName = strategy.Title
+		strategiesByName[strategy.Name] = strategy
+	}
+
+	return strategiesByName
+}
+
+func (t TestServer) getStrategy(name string) (unleash.StrategySchema, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	strategy, ok := t.strategies[name]
+	return strategy, ok
+}
+
+func (t TestServer) replaceStrategy(strategy unleash.StrategySchema) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.strategies[strategy.Name] = strategy
+}
+
+func (t TestServer) deleteStrategy(name string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.strategies, name)
+}
+
+func (t TestServer) GetAllStrategies(_ context.Context, _ unleash.GetAllStrategiesRequestObject) (unleash.GetAllStrategiesResponseObject, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	strategies := make([]unleash.StrategySchema, 0, len(t.strategies))
+	for _, strategy := range t.strategies {
+		strategies = append(strategies, strategy)
+	}
+	sort.Slice(strategies, func(i, j int) bool {
+		return strategies[i].Name < strategies[j].Name
+	})
+
+	return unleash.GetAllStrategies200JSONResponse{
+		Strategies: strategies,
+		Version:    1,
+	}, nil
+}
+
+func (t TestServer) CreateStrategy(_ context.Context, request unleash.CreateStrategyRequestObject) (unleash.CreateStrategyResponseObject, error) {
+	_, ok := t.getStrategy(request.Body.Name)
+	if ok {
+		return unleash.CreateStrategy409JSONResponse{}, nil
+	}
+	strategy := unleash.StrategySchema{
+		Name:        request.Body.Name,
+		Title:       request.Body.Title,
+		DisplayName: request.Body.Title,
+		Description: request.Body.Description,
+		Editable:    true,
+	}
+	if request.Body.Deprecated != nil {
+		strategy.Deprecated = *request.Body.Deprecated
+	}
+	if request.Body.Editable != nil {
+		strategy.Editable = *request.Body.Editable
+	}
+	strategy.Parameters = make([]strategyParameter, len(request.Body.Parameters))
+	for i, parameter := range request.Body.Parameters {
+		strategy.Parameters[i] = strategyParameter{
+			Description: parameter.Description,
+			Name:        ptr.ToPtr(parameter.Name),
+			Required:    parameter.Required,
+			Type:        ptr.ToPtr(string(parameter.Type)),
+		}
+	}
+	t.replaceStrategy(strategy)
+
+	return unleash.CreateStrategy201JSONResponse{
+		Body: strategy,
+		Headers: unleash.CreateStrategy201ResponseHeaders{
+			Location: "api/admin/strategies/" + strategy.Name,
+		},
+	}, nil
+}
+
+func (t TestServer) GetStrategy(_ context.Context, request unleash.GetStrategyRequestObject) (unleash.GetStrategyResponseObject, error) {
+	strategy, ok := t.getStrategy(request.Name)
+	if !ok {
+		return unleash.GetStrategy404JSONResponse{}, nil
+	}
+
+	return unleash.GetStrategy200JSONResponse(strategy), nil
+}
+
+// UpdateStrategy keeps the title since the generated server drops fields which are not in the API specification.
+func (t TestServer) UpdateStrategy(_ context.Context, request unleash.UpdateStrategyRequestObject) (unleash.UpdateStrategyResponseObject, error) {
+	strategy, ok := t.getStrategy(request.Name)
+	if !ok {
+		return unleash.UpdateStrategy404JSONResponse{}, nil
+	}
+	if !strategy.Editable {
+		return unleash.UpdateStrategy403JSONResponse{}, nil
+	}
+	strategy.Title = request.Body.Title
+	strategy.DisplayName = request.Body.Title
+	strategy.Description = request.Body.Description
+	strategy.Parameters = make([]strategyParameter, len(request.Body.Parameters))
+	for i, parameter := range request.Body.Parameters {
+		strategy.Parameters[i] = strategyParameter{
+			Description: parameter.Description,
+			Name:        ptr.ToPtr(parameter.Name),
+			Required:    parameter.Required,
+			Type:        ptr.ToPtr(string(parameter.Type)),
+		}
+	}
+	t.replaceStrategy(strategy)
+
+	return unleash.UpdateStrategy200Response{}, nil
+}
+
+func (t TestServer) RemoveStrategy(_ context.Context, request unleash.RemoveStrategyRequestObject) (unleash.RemoveStrategyResponseObject, error) {
+	strategy, ok := t.getStrategy(request.Name)
+	if !ok {
+		return unleash.RemoveStrategy404JSONResponse{}, nil
+	}
+	if !strategy.Editable {
+		return unleash.RemoveStrategy403JSONResponse{}, nil
+	}
+	t.deleteStrategy(request.Name)
+
+	return unleash.RemoveStrategy200Response{}, nil
+}
+
+func (t TestServer) DeprecateStrategy(_ context.Context, request unleash.DeprecateStrategyRequestObject) (unleash.DeprecateStrategyResponseObject, error) {
+	strategy, ok := t.getStrategy(request.StrategyName)
+	if !ok {
+		return unleash.DeprecateStrategy404JSONResponse{}, nil
+	}
+	strategy.Deprecated = true
+	t.replaceStrategy(strategy)
+
+	return unleash.DeprecateStrategy200Response{}, nil
+}
+
+func (t TestServer) ReactivateStrategy(_ context.Context, request unleash.ReactivateStrategyRequestObject) (unleash.ReactivateStrategyResponseObject, error) {
+	strategy, ok := t.getStrategy(request.StrategyName)
+	if !ok {
+		return unleash.ReactivateStrategy404JSONResponse{}, nil
+	}
+	strategy.Deprecated = false
+	t.replaceStrategy(strategy)
+
+	return unleash.ReactivateStrategy200Response{}, nil
+}
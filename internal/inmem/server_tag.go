@@ -0,0 +1,255 @@
+package inmem
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sort"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+func (t TestServer) getTagType(name string) (unleash.TagTypeSchema, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	tagType, ok := t.tagTypes[name]
+	return tagType, ok
+}
+
+func (t TestServer) replaceTagType(tagType unleash.TagTypeSchema) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.tagTypes[tagType.Name] = tagType
+}
+
+func (t TestServer) deleteTagType(name string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.tagTypes, name)
+	for _, projectFeatures := range t.features {
+		for featureName, feature := range projectFeatures {
+			if feature.Tags == nil {
+				continue
+			}
+			tags := slices.DeleteFunc(slices.Clone(*feature.Tags), func(tag unleash.TagSchema) bool {
+				return tag.Type == name
+			})
+			feature.Tags = &tags
+			projectFeatures[featureName] = feature
+		}
+	}
+}
+
+// findFeatureNoLock looks up a feature by its name in all projects as tag endpoints are not scoped by project.
+func (t TestServer) findFeatureNoLock(featureName string) (unleash.FeatureSchema, bool) {
+	for _, projectFeatures := range t.features {
+		feature, ok := projectFeatures[featureName]
+		if ok {
+			return feature, true
+		}
+	}
+
+	return unleash.FeatureSchema{}, false
+}
+
+// updateFeatureTags applies the given changes to the tags of the feature. It returns false if the feature or one of the tag types does not exist.
+func (t TestServer) updateFeatureTags(featureName string, addedTags []unleash.TagSchema, removedTags []unleash.TagSchema) ([]unleash.TagSchema, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	feature, ok := t.findFeatureNoLock(featureName)
+	if !ok {
+		return nil, false
+	}
+	for _, tag := range addedTags {
+		if _, ok := t.tagTypes[tag.Type]; !ok {
+			return nil, false
+		}
+	}
+
+	var tags []unleash.TagSchema
+	if feature.Tags != nil {
+		tags = slices.Clone(*feature.Tags)
+	}
+	tags = slices.DeleteFunc(tags, func(tag unleash.TagSchema) bool {
+		return slices.Contains(removedTags, tag)
+	})
+	for _, tag := range addedTags {
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	feature.Tags = &tags
+	t.features[*feature.Project][feature.Name] = feature
+
+	return tags, true
+}
+
+func (t TestServer) ListTags(_ context.Context, request unleash.ListTagsRequestObject) (unleash.ListTagsResponseObject, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	feature, ok := t.findFeatureNoLock(request.FeatureName)
+	if !ok {
+		return unleash.ListTags404JSONResponse{}, nil
+	}
+	tags := make([]unleash.TagSchema, 0)
+	if feature.Tags != nil {
+		tags = append(tags, *feature.Tags...)
+	}
+
+	return unleash.ListTags200JSONResponse{
+		Tags:    tags,
+		Version: 1,
+	}, nil
+}
+
+func (t TestServer) AddTag(_ context.Context, request unleash.AddTagRequestObject) (unleash.AddTagResponseObject, error) {
+	_, ok := t.updateFeatureTags(request.FeatureName, []unleash.TagSchema{*request.Body}, nil)
+	if !ok {
+		return unleash.AddTag404JSONResponse{}, nil
+	}
+
+	return unleash.AddTag201JSONResponse{
+		Body: *request.Body,
+		Headers: unleash.AddTag201ResponseHeaders{
+			Location: "api/admin/features/" + request.FeatureName + "/tags",
+		},
+	}, nil
+}
+
+func (t TestServer) UpdateTags(_ context.Context, request unleash.UpdateTagsRequestObject) (unleash.UpdateTagsResponseObject, error) {
+	tags, ok := t.updateFeatureTags(request.FeatureName, request.Body.AddedTags, request.Body.RemovedTags)
+	if !ok {
+		return unleash.UpdateTags404JSONResponse{}, nil
+	}
+
+	return unleash.UpdateTags200JSONResponse{
+		Body: unleash.TagsSchema{
+			Tags:    tags,
+			Version: 1,
+		},
+		Headers: unleash.UpdateTags200ResponseHeaders{
+			Location: "api/admin/features/" + request.FeatureName + "/tags",
+		},
+	}, nil
+}
+
+func (t TestServer) RemoveTag(_ context.Context, request unleash.RemoveTagRequestObject) (unleash.RemoveTagResponseObject, error) {
+	removedTag := unleash.TagSchema{
+		Type:  request.Type,
+		Value: request.Value,
+	}
+	_, ok := t.updateFeatureTags(request.FeatureName, nil, []unleash.TagSchema{removedTag})
+	if !ok {
+		return unleash.RemoveTag404JSONResponse{}, nil
+	}
+
+	return unleash.RemoveTag200Response{}, nil
+}
+
+func (t TestServer) GetTagTypes(_ context.Context, _ unleash.GetTagTypesRequestObject) (unleash.GetTagTypesResponseObject, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	tagTypes := make([]unleash.TagTypeSchema, 0, len(t.tagTypes))
+	for _, tagType := range t.tagTypes {
+		tagTypes = append(tagTypes, tagType)
+	}
+	sort.Slice(tagTypes, func(i, j int) bool {
+		return tagTypes[i].Name < tagTypes[j].Name
+	})
+
+	return unleash.GetTagTypes200JSONResponse{
+		TagTypes: tagTypes,
+		Version:  1,
+	}, nil
+}
+
+func (t TestServer) CreateTagType(_ context.Context, request unleash.CreateTagTypeRequestObject) (unleash.CreateTagTypeResponseObject, error) {
+	_, ok := t.getTagType(request.Body.Name)
+	if ok {
+		return unleash.CreateTagType409JSONResponse{}, nil
+	}
+	tagType := unleash.TagTypeSchema{
+		Name:        request.Body.Name,
+		Description: request.Body.Description,
+		Icon:        request.Body.Icon,
+	}
+	t.replaceTagType(tagType)
+
+	return unleash.CreateTagType201JSONResponse{
+		Body: tagType,
+		Headers: unleash.CreateTagType201ResponseHeaders{
+			Location: "api/admin/tag-types/" + tagType.Name,
+		},
+	}, nil
+}
+
+func (t TestServer) GetTagType(_ context.Context, request unleash.GetTagTypeRequestObject) (unleash.GetTagTypeResponseObject, error) {
+	tagType, ok := t.getTagType(request.Name)
+	if !ok {
+		return GetTagType404JSONResponse{}, nil
+	}
+
+	return unleash.GetTagType200JSONResponse(tagType), nil
+}
+
+func (t TestServer) UpdateTagType(_ context.Context, request unleash.UpdateTagTypeRequestObject) (unleash.UpdateTagTypeResponseObject, error) {
+	tagType, ok := t.getTagType(request.Name)
+	if !ok {
+		return UpdateTagType404JSONResponse{}, nil
+	}
+	tagType.Description = request.Body.Description
+	tagType.Icon = request.Body.Icon
+	t.replaceTagType(tagType)
+
+	return unleash.UpdateTagType200Response{}, nil
+}
+
+func (t TestServer) DeleteTagType(_ context.Context, request unleash.DeleteTagTypeRequestObject) (unleash.DeleteTagTypeResponseObject, error) {
+	// the real server responds 200 even though the tag type does not exist
+	t.deleteTagType(request.Name)
+
+	return unleash.DeleteTagType200Response{}, nil
+}
+
+type GetTagType404JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response GetTagType404JSONResponse) VisitGetTagTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTagType404JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response UpdateTagType404JSONResponse) VisitUpdateTagTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
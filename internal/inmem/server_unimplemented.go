@@ -6,71 +6,11 @@ import (
 	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
 )
 
-func (t TestServer) GetAddons(ctx context.Context, request unleash.GetAddonsRequestObject) (unleash.GetAddonsResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) CreateAddon(ctx context.Context, request unleash.CreateAddonRequestObject) (unleash.CreateAddonResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) DeleteAddon(ctx context.Context, request unleash.DeleteAddonRequestObject) (unleash.DeleteAddonResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) GetAddon(ctx context.Context, request unleash.GetAddonRequestObject) (unleash.GetAddonResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) UpdateAddon(ctx context.Context, request unleash.UpdateAddonRequestObject) (unleash.UpdateAddonResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) GetAllApiTokens(ctx context.Context, request unleash.GetAllApiTokensRequestObject) (unleash.GetAllApiTokensResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) CreateApiToken(ctx context.Context, request unleash.CreateApiTokenRequestObject) (unleash.CreateApiTokenResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) GetApiTokensByName(ctx context.Context, request unleash.GetApiTokensByNameRequestObject) (unleash.GetApiTokensByNameResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) DeleteApiToken(ctx context.Context, request unleash.DeleteApiTokenRequestObject) (unleash.DeleteApiTokenResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) UpdateApiToken(ctx context.Context, request unleash.UpdateApiTokenRequestObject) (unleash.UpdateApiTokenResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) GetArchivedFeatures(ctx context.Context, request unleash.GetArchivedFeaturesRequestObject) (unleash.GetArchivedFeaturesResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (t TestServer) GetArchivedFeaturesByProjectId(ctx context.Context, request unleash.GetArchivedFeaturesByProjectIdRequestObject) (unleash.GetArchivedFeaturesByProjectIdResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) ReviveFeature(ctx context.Context, request unleash.ReviveFeatureRequestObject) (unleash.ReviveFeatureResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) GetFeatureUsageSummary(ctx context.Context, request unleash.GetFeatureUsageSummaryRequestObject) (unleash.GetFeatureUsageSummaryResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
@@ -86,71 +26,21 @@ func (t TestServer) ValidateConstraint(ctx context.Context, request unleash.Vali
 	panic("implement me")
 }
 
-func (t TestServer) GetContextFields(ctx context.Context, request unleash.GetContextFieldsRequestObject) (unleash.GetContextFieldsResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) CreateContextField(ctx context.Context, request unleash.CreateContextFieldRequestObject) (unleash.CreateContextFieldResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) Validate(ctx context.Context, request unleash.ValidateRequestObject) (unleash.ValidateResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (t TestServer) DeleteContextField(ctx context.Context, request unleash.DeleteContextFieldRequestObject) (unleash.DeleteContextFieldResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) GetContextField(ctx context.Context, request unleash.GetContextFieldRequestObject) (unleash.GetContextFieldResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) UpdateContextField(ctx context.Context, request unleash.UpdateContextFieldRequestObject) (unleash.UpdateContextFieldResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) GetStrategiesByContextField(ctx context.Context, request unleash.GetStrategiesByContextFieldRequestObject) (unleash.GetStrategiesByContextFieldResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (t TestServer) GetAllEnvironments(ctx context.Context, request unleash.GetAllEnvironmentsRequestObject) (unleash.GetAllEnvironmentsResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) GetProjectEnvironments(ctx context.Context, request unleash.GetProjectEnvironmentsRequestObject) (unleash.GetProjectEnvironmentsResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (t TestServer) UpdateSortOrder(ctx context.Context, request unleash.UpdateSortOrderRequestObject) (unleash.UpdateSortOrderResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) GetEnvironment(ctx context.Context, request unleash.GetEnvironmentRequestObject) (unleash.GetEnvironmentResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) ToggleEnvironmentOff(ctx context.Context, request unleash.ToggleEnvironmentOffRequestObject) (unleash.ToggleEnvironmentOffResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) ToggleEnvironmentOn(ctx context.Context, request unleash.ToggleEnvironmentOnRequestObject) (unleash.ToggleEnvironmentOnResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) GetEvents(ctx context.Context, request unleash.GetEventsRequestObject) (unleash.GetEventsResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
@@ -201,26 +91,6 @@ func (t TestServer) ValidateFeature(ctx context.Context, request unleash.Validat
 	panic("implement me")
 }
 
-func (t TestServer) ListTags(ctx context.Context, request unleash.ListTagsRequestObject) (unleash.ListTagsResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) AddTag(ctx context.Context, request unleash.AddTagRequestObject) (unleash.AddTagResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) UpdateTags(ctx context.Context, request unleash.UpdateTagsRequestObject) (unleash.UpdateTagsResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) RemoveTag(ctx context.Context, request unleash.RemoveTagRequestObject) (unleash.RemoveTagResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) CreateFeedback(ctx context.Context, request unleash.CreateFeedbackRequestObject) (unleash.CreateFeedbackResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
@@ -241,26 +111,6 @@ func (t TestServer) GetInstanceAdminStatsCsv(ctx context.Context, request unleas
 	panic("implement me")
 }
 
-func (t TestServer) GetAllPublicSignupTokens(ctx context.Context, request unleash.GetAllPublicSignupTokensRequestObject) (unleash.GetAllPublicSignupTokensResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) CreatePublicSignupToken(ctx context.Context, request unleash.CreatePublicSignupTokenRequestObject) (unleash.CreatePublicSignupTokenResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) GetPublicSignupToken(ctx context.Context, request unleash.GetPublicSignupTokenRequestObject) (unleash.GetPublicSignupTokenResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) UpdatePublicSignupToken(ctx context.Context, request unleash.UpdatePublicSignupTokenRequestObject) (unleash.UpdatePublicSignupTokenResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) GetMaintenance(ctx context.Context, request unleash.GetMaintenanceRequestObject) (unleash.GetMaintenanceResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
@@ -301,11 +151,6 @@ func (t TestServer) GetAdvancedPlayground(ctx context.Context, request unleash.G
 	panic("implement me")
 }
 
-func (t TestServer) GetProjects(ctx context.Context, request unleash.GetProjectsRequestObject) (unleash.GetProjectsResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) GetDeprecatedProjectOverview(ctx context.Context, request unleash.GetDeprecatedProjectOverviewRequestObject) (unleash.GetDeprecatedProjectOverviewResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
@@ -361,21 +206,6 @@ func (t TestServer) GetProjectDora(ctx context.Context, request unleash.GetProje
 	panic("implement me")
 }
 
-func (t TestServer) AddEnvironmentToProject(ctx context.Context, request unleash.AddEnvironmentToProjectRequestObject) (unleash.AddEnvironmentToProjectResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) RemoveEnvironmentFromProject(ctx context.Context, request unleash.RemoveEnvironmentFromProjectRequestObject) (unleash.RemoveEnvironmentFromProjectResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) AddDefaultStrategyToProjectEnvironment(ctx context.Context, request unleash.AddDefaultStrategyToProjectEnvironmentRequestObject) (unleash.AddDefaultStrategyToProjectEnvironmentResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) RemoveFavoriteProject(ctx context.Context, request unleash.RemoveFavoriteProjectRequestObject) (unleash.RemoveFavoriteProjectResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
@@ -386,21 +216,6 @@ func (t TestServer) AddFavoriteProject(ctx context.Context, request unleash.AddF
 	panic("implement me")
 }
 
-func (t TestServer) DeleteFeatureDependencies(ctx context.Context, request unleash.DeleteFeatureDependenciesRequestObject) (unleash.DeleteFeatureDependenciesResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) AddFeatureDependency(ctx context.Context, request unleash.AddFeatureDependencyRequestObject) (unleash.AddFeatureDependencyResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) DeleteFeatureDependency(ctx context.Context, request unleash.DeleteFeatureDependencyRequestObject) (unleash.DeleteFeatureDependencyResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) ListParentOptions(ctx context.Context, request unleash.ListParentOptionsRequestObject) (unleash.ListParentOptionsResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
@@ -421,11 +236,6 @@ func (t TestServer) GetFeatureEnvironment(ctx context.Context, request unleash.G
 	panic("implement me")
 }
 
-func (t TestServer) GetFeatureStrategy(ctx context.Context, request unleash.GetFeatureStrategyRequestObject) (unleash.GetFeatureStrategyResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) PatchFeatureStrategy(ctx context.Context, request unleash.PatchFeatureStrategyRequestObject) (unleash.PatchFeatureStrategyResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
@@ -466,21 +276,11 @@ func (t TestServer) GetProjectHealthReport(ctx context.Context, request unleash.
 	panic("implement me")
 }
 
-func (t TestServer) GetProjectOverview(ctx context.Context, request unleash.GetProjectOverviewRequestObject) (unleash.GetProjectOverviewResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) ReviveFeatures(ctx context.Context, request unleash.ReviveFeaturesRequestObject) (unleash.ReviveFeaturesResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (t TestServer) StaleFeatures(ctx context.Context, request unleash.StaleFeaturesRequestObject) (unleash.StaleFeaturesResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) AddTagToFeatures(ctx context.Context, request unleash.AddTagToFeaturesRequestObject) (unleash.AddTagToFeaturesResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
@@ -501,11 +301,6 @@ func (t TestServer) ValidateSegment(ctx context.Context, request unleash.Validat
 	panic("implement me")
 }
 
-func (t TestServer) GetStrategiesBySegmentId(ctx context.Context, request unleash.GetStrategiesBySegmentIdRequestObject) (unleash.GetStrategiesBySegmentIdResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) UpdateSplashSettings(ctx context.Context, request unleash.UpdateSplashSettingsRequestObject) (unleash.UpdateSplashSettingsResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
@@ -521,71 +316,11 @@ func (t TestServer) Import(ctx context.Context, request unleash.ImportRequestObj
 	panic("implement me")
 }
 
-func (t TestServer) GetAllStrategies(ctx context.Context, request unleash.GetAllStrategiesRequestObject) (unleash.GetAllStrategiesResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) CreateStrategy(ctx context.Context, request unleash.CreateStrategyRequestObject) (unleash.CreateStrategyResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) RemoveStrategy(ctx context.Context, request unleash.RemoveStrategyRequestObject) (unleash.RemoveStrategyResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) GetStrategy(ctx context.Context, request unleash.GetStrategyRequestObject) (unleash.GetStrategyResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) UpdateStrategy(ctx context.Context, request unleash.UpdateStrategyRequestObject) (unleash.UpdateStrategyResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) DeprecateStrategy(ctx context.Context, request unleash.DeprecateStrategyRequestObject) (unleash.DeprecateStrategyResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) ReactivateStrategy(ctx context.Context, request unleash.ReactivateStrategyRequestObject) (unleash.ReactivateStrategyResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) GetTagTypes(ctx context.Context, request unleash.GetTagTypesRequestObject) (unleash.GetTagTypesResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) CreateTagType(ctx context.Context, request unleash.CreateTagTypeRequestObject) (unleash.CreateTagTypeResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) ValidateTagType(ctx context.Context, request unleash.ValidateTagTypeRequestObject) (unleash.ValidateTagTypeResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
-func (t TestServer) DeleteTagType(ctx context.Context, request unleash.DeleteTagTypeRequestObject) (unleash.DeleteTagTypeResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) GetTagType(ctx context.Context, request unleash.GetTagTypeRequestObject) (unleash.GetTagTypeResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) UpdateTagType(ctx context.Context, request unleash.UpdateTagTypeRequestObject) (unleash.UpdateTagTypeResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) GetTags(ctx context.Context, request unleash.GetTagsRequestObject) (unleash.GetTagsResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
@@ -636,11 +371,6 @@ func (t TestServer) GetUsers(ctx context.Context, request unleash.GetUsersReques
 	panic("implement me")
 }
 
-func (t TestServer) CreateUser(ctx context.Context, request unleash.CreateUserRequestObject) (unleash.CreateUserResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) GetBaseUsersAndGroups(ctx context.Context, request unleash.GetBaseUsersAndGroupsRequestObject) (unleash.GetBaseUsersAndGroupsResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
@@ -666,21 +396,6 @@ func (t TestServer) ValidateUserPassword(ctx context.Context, request unleash.Va
 	panic("implement me")
 }
 
-func (t TestServer) DeleteUser(ctx context.Context, request unleash.DeleteUserRequestObject) (unleash.DeleteUserResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) GetUser(ctx context.Context, request unleash.GetUserRequestObject) (unleash.GetUserResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) UpdateUser(ctx context.Context, request unleash.UpdateUserRequestObject) (unleash.UpdateUserResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) ChangeUserPassword(ctx context.Context, request unleash.ChangeUserPasswordRequestObject) (unleash.ChangeUserPasswordResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
@@ -696,21 +411,6 @@ func (t TestServer) GetProfile(ctx context.Context, request unleash.GetProfileRe
 	panic("implement me")
 }
 
-func (t TestServer) GetPats(ctx context.Context, request unleash.GetPatsRequestObject) (unleash.GetPatsResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) CreatePat(ctx context.Context, request unleash.CreatePatRequestObject) (unleash.CreatePatResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (t TestServer) DeletePat(ctx context.Context, request unleash.DeletePatRequestObject) (unleash.DeletePatResponseObject, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
 func (t TestServer) GetAllClientFeatures(ctx context.Context, request unleash.GetAllClientFeaturesRequestObject) (unleash.GetAllClientFeaturesResponseObject, error) {
 	//TODO implement me
 	panic("implement me")
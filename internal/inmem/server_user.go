@@ -0,0 +1,327 @@
+package inmem
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+// rootRoles are the predefined root roles of Unleash by ID.
+var rootRoles = map[int]string{
+	1: "Admin",
+	2: "Editor",
+	3: "Viewer",
+}
+
+// toRootRoleID resolves a root role which is either its ID or its name.
+func toRootRoleID(rootRole json.Marshaler) (int, bool) {
+	b, err := rootRole.MarshalJSON()
+	if err != nil {
+		return 0, false
+	}
+	var id int
+	if json.Unmarshal(b, &id) == nil {
+		_, ok := rootRoles[id]
+		return id, ok
+	}
+	var name string
+	if json.Unmarshal(b, &name) == nil {
+		for roleID, roleName := range rootRoles {
+			if roleName == name {
+				return roleID, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+func toCreateUserResponse(user unleash.UserSchema) (unleash.CreateUserResponseSchema, error) {
+	response := unleash.CreateUserResponseSchema{
+		Id:          user.Id,
+		Email:       user.Email,
+		Name:        user.Name,
+		Username:    user.Username,
+		EmailSent:   user.EmailSent,
+		AccountType: user.AccountType,
+		CreatedAt:   user.CreatedAt,
+	}
+	if user.RootRole != nil {
+		response.RootRole = &unleash.CreateUserResponseSchema_RootRole{}
+		err := response.RootRole.FromCreateUserResponseSchemaRootRole0(*user.RootRole)
+		if err != nil {
+			return response, err
+		}
+	}
+
+	return response, nil
+}
+
+func (t TestServer) getUser(id string) (unleash.UserSchema, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	user, ok := t.users[id]
+	return user, ok
+}
+
+func (t TestServer) replaceUser(user unleash.UserSchema) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.users[strconv.Itoa(user.Id)] = user
+}
+
+func (t TestServer) deleteUser(id string) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	_, ok := t.users[id]
+	if !ok {
+		return false
+	}
+	delete(t.users, id)
+
+	return true
+}
+
+func (t TestServer) CreateUser(_ context.Context, request unleash.CreateUserRequestObject) (unleash.CreateUserResponseObject, error) {
+	if request.Body.Email == nil && request.Body.Username == nil {
+		return unleash.CreateUser400JSONResponse{
+			Message: ptr.ToPtr("You must specify username or email"),
+		}, nil
+	}
+	rootRole, ok := toRootRoleID(request.Body.RootRole)
+	if !ok {
+		return unleash.CreateUser400JSONResponse{
+			Message: ptr.ToPtr("Could not find root role"),
+		}, nil
+	}
+	sendEmail := request.Body.SendEmail == nil || *request.Body.SendEmail
+	user := unleash.UserSchema{
+		Id:          int(t.next.Add(1)),
+		Email:       request.Body.Email,
+		Name:        request.Body.Name,
+		Username:    request.Body.Username,
+		RootRole:    ptr.ToPtr(rootRole),
+		EmailSent:   ptr.ToPtr(sendEmail && request.Body.Email != nil),
+		AccountType: ptr.ToPtr("User"),
+		CreatedAt:   ptr.ToPtr(time.Now()),
+	}
+	t.replaceUser(user)
+
+	response, err := toCreateUserResponse(user)
+	if err != nil {
+		return nil, err
+	}
+	return unleash.CreateUser201JSONResponse{
+		Body: response,
+		Headers: unleash.CreateUser201ResponseHeaders{
+			Location: "api/admin/user-admin/" + strconv.Itoa(user.Id),
+		},
+	}, nil
+}
+
+func (t TestServer) GetUser(_ context.Context, request unleash.GetUserRequestObject) (unleash.GetUserResponseObject, error) {
+	user, ok := t.getUser(request.Id)
+	if !ok {
+		return unleash.GetUser404JSONResponse{}, nil
+	}
+
+	return unleash.GetUser200JSONResponse(user), nil
+}
+
+func (t TestServer) UpdateUser(_ context.Context, request unleash.UpdateUserRequestObject) (unleash.UpdateUserResponseObject, error) {
+	user, ok := t.getUser(request.Id)
+	if !ok {
+		return unleash.UpdateUser404JSONResponse{}, nil
+	}
+	if request.Body.RootRole != nil {
+		rootRole, ok := toRootRoleID(request.Body.RootRole)
+		if !ok {
+			return unleash.UpdateUser400JSONResponse{
+				Message: ptr.ToPtr("Could not find root role"),
+			}, nil
+		}
+		user.RootRole = ptr.ToPtr(rootRole)
+	}
+	if request.Body.Email != nil {
+		user.Email = request.Body.Email
+	}
+	if request.Body.Name != nil {
+		user.Name = request.Body.Name
+	}
+	t.replaceUser(user)
+
+	response, err := toCreateUserResponse(user)
+	if err != nil {
+		return nil, err
+	}
+	return unleash.UpdateUser200JSONResponse(response), nil
+}
+
+func (t TestServer) DeleteUser(_ context.Context, request unleash.DeleteUserRequestObject) (unleash.DeleteUserResponseObject, error) {
+	if !t.deleteUser(request.Id) {
+		return unleash.DeleteUser404JSONResponse{}, nil
+	}
+
+	return unleash.DeleteUser200Response{}, nil
+}
+
+func (t TestServer) GetPats(_ context.Context, _ unleash.GetPatsRequestObject) (unleash.GetPatsResponseObject, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	pats := make([]unleash.PatSchema, 0, len(t.pats))
+	for _, pat := range t.pats {
+		// the secret is only returned when the token is created
+		pat.Secret = nil
+		pats = append(pats, pat)
+	}
+	sort.Slice(pats, func(i, j int) bool {
+		return *pats[i].Id < *pats[j].Id
+	})
+
+	return unleash.GetPats200JSONResponse{
+		Pats: &pats,
+	}, nil
+}
+
+func (t TestServer) CreatePat(_ context.Context, request unleash.CreatePatRequestObject) (unleash.CreatePatResponseObject, error) {
+	id := int(t.next.Add(1))
+	pat := unleash.PatSchema{
+		Id:          ptr.ToPtr(id),
+		Description: ptr.ToPtr(request.Body.Description),
+		ExpiresAt:   ptr.ToPtr(request.Body.ExpiresAt),
+		Secret:      ptr.ToPtr("user:" + t.getNext("pat")),
+		CreatedAt:   ptr.ToPtr(time.Now()),
+	}
+
+	t.lock.Lock()
+	t.pats[strconv.Itoa(id)] = pat
+	t.lock.Unlock()
+
+	return unleash.CreatePat201JSONResponse{
+		Body: pat,
+		Headers: unleash.CreatePat201ResponseHeaders{
+			Location: "api/admin/user/tokens/" + strconv.Itoa(id),
+		},
+	}, nil
+}
+
+func (t TestServer) DeletePat(_ context.Context, request unleash.DeletePatRequestObject) (unleash.DeletePatResponseObject, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	// the real server responds 200 even though the token does not exist
+	delete(t.pats, request.Id)
+
+	return unleash.DeletePat200Response{}, nil
+}
+
+func (t TestServer) getPublicSignupToken(secret string) (unleash.PublicSignupTokenSchema, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	token, ok := t.publicSignupTokens[secret]
+	return token, ok
+}
+
+func (t TestServer) replacePublicSignupToken(token unleash.PublicSignupTokenSchema) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.publicSignupTokens[token.Secret] = token
+}
+
+func (t TestServer) GetAllPublicSignupTokens(_ context.Context, _ unleash.GetAllPublicSignupTokensRequestObject) (unleash.GetAllPublicSignupTokensResponseObject, error) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	tokens := make([]unleash.PublicSignupTokenSchema, 0, len(t.publicSignupTokens))
+	for _, token := range t.publicSignupTokens {
+		tokens = append(tokens, token)
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].Secret < tokens[j].Secret
+	})
+
+	return unleash.GetAllPublicSignupTokens200JSONResponse{
+		Tokens: tokens,
+	}, nil
+}
+
+func (t TestServer) CreatePublicSignupToken(_ context.Context, request unleash.CreatePublicSignupTokenRequestObject) (unleash.CreatePublicSignupTokenResponseObject, error) {
+	secret := t.getNext("signup")
+	token := unleash.PublicSignupTokenSchema{
+		Name:      request.Body.Name,
+		Secret:    secret,
+		Url:       ptr.ToPtr("http://localhost/new-user?invite=" + secret),
+		Enabled:   true,
+		ExpiresAt: request.Body.ExpiresAt,
+		CreatedAt: time.Now(),
+		Role: unleash.RoleSchema{
+			Id:   3,
+			Name: rootRoles[3],
+			Type: "root",
+		},
+		Users: &[]unleash.UserSchema{},
+	}
+	t.replacePublicSignupToken(token)
+
+	return unleash.CreatePublicSignupToken201JSONResponse{
+		Body: token,
+		Headers: unleash.CreatePublicSignupToken201ResponseHeaders{
+			Location: "api/admin/invite-link/tokens/" + secret,
+		},
+	}, nil
+}
+
+func (t TestServer) GetPublicSignupToken(_ context.Context, request unleash.GetPublicSignupTokenRequestObject) (unleash.GetPublicSignupTokenResponseObject, error) {
+	token, ok := t.getPublicSignupToken(request.Token)
+	if !ok {
+		return GetPublicSignupToken404JSONResponse{}, nil
+	}
+
+	return unleash.GetPublicSignupToken200JSONResponse(token), nil
+}
+
+func (t TestServer) UpdatePublicSignupToken(_ context.Context, request unleash.UpdatePublicSignupTokenRequestObject) (unleash.UpdatePublicSignupTokenResponseObject, error) {
+	token, ok := t.getPublicSignupToken(request.Token)
+	if !ok {
+		return unleash.UpdatePublicSignupToken400JSONResponse{}, nil
+	}
+	if request.Body.Enabled != nil {
+		token.Enabled = *request.Body.Enabled
+	}
+	if request.Body.ExpiresAt != nil {
+		token.ExpiresAt = *request.Body.ExpiresAt
+	}
+	t.replacePublicSignupToken(token)
+
+	return unleash.UpdatePublicSignupToken200JSONResponse(token), nil
+}
+
+type GetPublicSignupToken404JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response GetPublicSignupToken404JSONResponse) VisitGetPublicSignupTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
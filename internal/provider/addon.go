@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+// AddonModel names the addon provider provider_name since provider is a meta-argument of Terraform.
+type AddonModel struct {
+	ID           types.String            `tfsdk:"id"`
+	Provider     types.String            `tfsdk:"provider_name"`
+	Description  types.String            `tfsdk:"description"`
+	Enabled      types.Bool              `tfsdk:"enabled"`
+	Events       []types.String          `tfsdk:"events"`
+	Projects     []types.String          `tfsdk:"projects"`
+	Environments []types.String          `tfsdk:"environments"`
+	Parameters   map[string]types.String `tfsdk:"parameters"`
+}
+
+func createAddonResourceSchemaAttr() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "ID of this addon",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"provider_name": schema.StringAttribute{
+			Description: "The name of the addon provider, e.g. webhook, slack, teams or datadog. Changing it creates a new addon.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"description": schema.StringAttribute{
+			Description: "A description of this addon",
+			Optional:    true,
+		},
+		"enabled": schema.BoolAttribute{
+			Description: "Whether this addon is enabled",
+			Required:    true,
+		},
+		"events": schema.SetAttribute{
+			Description: "The event types which trigger this addon, e.g. feature-created",
+			Required:    true,
+			ElementType: types.StringType,
+		},
+		"projects": schema.SetAttribute{
+			Description: "The projects whose events trigger this addon. Events of all projects trigger it if it is not specified",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"environments": schema.SetAttribute{
+			Description: "The environments whose events trigger this addon. Events of all environments trigger it if it is not specified",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"parameters": schema.MapAttribute{
+			Description: "The parameters of the addon provider, e.g. url of webhook. Unleash does not return sensitive parameters " +
+				"so changes of them made outside Terraform are not detected",
+			Optional:    true,
+			Sensitive:   true,
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func toAddonModel(addon *unleash.AddonSchema) (AddonModel, error) {
+	addonModel := AddonModel{
+		ID:       types.StringValue(fmt.Sprintf("%d", addon.Id)),
+		Provider: types.StringValue(addon.Provider),
+		Enabled:  types.BoolValue(addon.Enabled),
+	}
+	if addon.Description != nil && *addon.Description != "" {
+		addonModel.Description = types.StringValue(*addon.Description)
+	}
+	for _, event := range addon.Events {
+		addonModel.Events = append(addonModel.Events, types.StringValue(event))
+	}
+	if addon.Projects != nil {
+		for _, project := range *addon.Projects {
+			addonModel.Projects = append(addonModel.Projects, types.StringValue(project))
+		}
+	}
+	if addon.Environments != nil {
+		for _, environment := range *addon.Environments {
+			addonModel.Environments = append(addonModel.Environments, types.StringValue(environment))
+		}
+	}
+	if len(addon.Parameters) > 0 {
+		addonModel.Parameters = make(map[string]types.String, len(addon.Parameters))
+		for name, value := range addon.Parameters {
+			if s, ok := value.(string); ok {
+				addonModel.Parameters[name] = types.StringValue(s)
+				continue
+			}
+			b, err := json.Marshal(value)
+			if err != nil {
+				return AddonModel{}, err
+			}
+			addonModel.Parameters[name] = types.StringValue(string(b))
+		}
+	}
+
+	return addonModel, nil
+}
+
+// isMaskedAddonParameter reports whether Unleash replaced the value of a sensitive parameter with asterisks.
+func isMaskedAddonParameter(value types.String) bool {
+	return value.ValueString() != "" && strings.Trim(value.ValueString(), "*") == ""
+}
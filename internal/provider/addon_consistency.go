@@ -0,0 +1,28 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+func ensureAddonModelNullAndEmptyConsistency(addonModel *AddonModel, addonModelBefore AddonModel) {
+	tryUpdateToEmptyStringIfBeforeEmpty(addonModel.Description, addonModelBefore.Description, func(value types.String) {
+		addonModel.Description = value
+	})
+	if isNullArrayAndExistingEmptyArray(addonModel.Events, addonModelBefore.Events) {
+		addonModel.Events = []types.String{}
+	}
+	if isNullArrayAndExistingEmptyArray(addonModel.Projects, addonModelBefore.Projects) {
+		addonModel.Projects = []types.String{}
+	}
+	if isNullArrayAndExistingEmptyArray(addonModel.Environments, addonModelBefore.Environments) {
+		addonModel.Environments = []types.String{}
+	}
+	if isNullMapAndExistingEmptyMap(addonModel.Parameters, addonModelBefore.Parameters) {
+		addonModel.Parameters = map[string]types.String{}
+	}
+	// keep the known values of sensitive parameters which Unleash masks
+	for name, value := range addonModel.Parameters {
+		valueBefore, ok := addonModelBefore.Parameters[name]
+		if ok && isMaskedAddonParameter(value) {
+			addonModel.Parameters[name] = valueBefore
+		}
+	}
+}
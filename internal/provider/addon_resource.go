@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+var _ resource.Resource = &AddonResource{}
+var _ resource.ResourceWithImportState = &AddonResource{}
+
+func NewAddonResource() resource.Resource {
+	return &AddonResource{}
+}
+
+type AddonResource struct {
+	providerData UnleashProviderData
+}
+
+type AddonResourceModel struct {
+	AddonModel
+}
+
+func (r *AddonResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_addon"
+}
+
+func (r *AddonResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Addon resource which notifies integrations such as webhooks and Slack of Unleash events. " +
+			"An addon is imported by its ID. Sensitive parameters of an imported addon are masked until they are applied.",
+
+		Attributes: createAddonResourceSchemaAttr(),
+	}
+}
+
+func (r *AddonResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *AddonResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AddonResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := toAddonBody(data)
+	// parameters are not logged since they may be sensitive
+	tflog.Debug(ctx, "Creating addon", map[string]interface{}{"provider": body.Provider, "events": body.Events})
+	createResp, err := r.providerData.Client.CreateAddonWithResponse(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create addon "+data.Provider.String(), err.Error())
+		return
+	}
+	if createResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to create addon "+data.Provider.String(), fmt.Sprintf(" with status %d %s", createResp.StatusCode(), string(createResp.Body)))
+		return
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%d", createResp.JSON200.Id))
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AddonResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AddonResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading addon", map[string]interface{}{"id": data.ID.ValueString()})
+	readResp, err := r.providerData.Client.GetAddonWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get addon", err.Error())
+		return
+	}
+	if readResp.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if readResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to read addon "+data.ID.String(), fmt.Sprintf(" with status %d %s", readResp.StatusCode(), string(readResp.Body)))
+		return
+	}
+	addonModel, err := toAddonModel(readResp.JSON200)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read addon "+data.ID.String(), err.Error())
+		return
+	}
+	ensureAddonModelNullAndEmptyConsistency(&addonModel, data.AddonModel)
+	data.AddonModel = addonModel
+
+	tflog.Trace(ctx, "read resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AddonResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AddonResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the whole addon is sent because sensitive parameters cannot be compared with the masked values of Unleash
+	body := toAddonBody(data)
+	tflog.Debug(ctx, "Updating addon", map[string]interface{}{"id": data.ID.ValueString(), "events": body.Events})
+	updateResp, err := r.providerData.Client.UpdateAddonWithResponse(ctx, data.ID.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update addon "+data.ID.String(), err.Error())
+		return
+	}
+	if updateResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to update addon "+data.ID.String(), fmt.Sprintf(" with status %d %s", updateResp.StatusCode(), string(updateResp.Body)))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func toAddonBody(data AddonResourceModel) unleash.AddonCreateUpdateSchema {
+	body := unleash.AddonCreateUpdateSchema{
+		Provider:     data.Provider.ValueString(),
+		Description:  data.Description.ValueStringPointer(),
+		Enabled:      data.Enabled.ValueBool(),
+		Events:       make([]string, 0, len(data.Events)),
+		Parameters:   make(map[string]interface{}, len(data.Parameters)),
+		Projects:     toStringSlice(data.Projects),
+		Environments: toStringSlice(data.Environments),
+	}
+	if body.Description == nil {
+		body.Description = ptr.ToPtr("")
+	}
+	for _, event := range data.Events {
+		body.Events = append(body.Events, event.ValueString())
+	}
+	for name, value := range data.Parameters {
+		body.Parameters[name] = value.ValueString()
+	}
+
+	return body
+}
+
+func toStringSlice(values []types.String) *[]string {
+	slice := make([]string, 0, len(values))
+	for _, value := range values {
+		slice = append(slice, value.ValueString())
+	}
+
+	return &slice
+}
+
+func (r *AddonResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AddonResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting addon", map[string]interface{}{"id": data.ID.ValueString()})
+	deleteResp, err := r.providerData.Client.DeleteAddonWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete addon "+data.ID.String(), err.Error())
+		return
+	}
+	if deleteResp.StatusCode() > 299 && deleteResp.StatusCode() != 404 {
+		resp.Diagnostics.AddError("failed to delete addon "+data.ID.String(), fmt.Sprintf(" with status %d %s", deleteResp.StatusCode(), string(deleteResp.Body)))
+		return
+	}
+}
+
+func (r *AddonResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,79 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestAccAddonResource(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_addon" "slack" {
+	provider_name = "slack"
+	description = "Notify #feature-flags"
+	enabled = true
+	events = ["feature-created", "feature-updated"]
+	parameters = {
+		url = "https://hooks.slack.com/services/secret"
+		username = "unleash"
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unleash_addon.slack", "id"),
+					resource.TestCheckResourceAttr("unleash_addon.slack", "provider_name", "slack"),
+					resource.TestCheckResourceAttr("unleash_addon.slack", "description", "Notify #feature-flags"),
+					resource.TestCheckResourceAttr("unleash_addon.slack", "enabled", "true"),
+					resource.TestCheckResourceAttr("unleash_addon.slack", "events.#", "2"),
+					resource.TestCheckNoResourceAttr("unleash_addon.slack", "projects"),
+					resource.TestCheckNoResourceAttr("unleash_addon.slack", "environments"),
+					// the sensitive url which Unleash masks is kept
+					resource.TestCheckResourceAttr("unleash_addon.slack", "parameters.url", "https://hooks.slack.com/services/secret"),
+					resource.TestCheckResourceAttr("unleash_addon.slack", "parameters.username", "unleash"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "unleash_addon.slack",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameters.url"},
+			},
+			//	Update and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_addon" "slack" {
+	provider_name = "slack"
+	enabled = false
+	events = ["feature-archived"]
+	projects = ["default"]
+	environments = ["production"]
+	parameters = {
+		url = "https://hooks.slack.com/services/rotated"
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("unleash_addon.slack", "description"),
+					resource.TestCheckResourceAttr("unleash_addon.slack", "enabled", "false"),
+					resource.TestCheckResourceAttr("unleash_addon.slack", "events.#", "1"),
+					resource.TestCheckResourceAttr("unleash_addon.slack", "events.0", "feature-archived"),
+					resource.TestCheckResourceAttr("unleash_addon.slack", "projects.0", "default"),
+					resource.TestCheckResourceAttr("unleash_addon.slack", "environments.0", "production"),
+					resource.TestCheckResourceAttr("unleash_addon.slack", "parameters.url", "https://hooks.slack.com/services/rotated"),
+					resource.TestCheckNoResourceAttr("unleash_addon.slack", "parameters.username"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+type ApiTokenModel struct {
+	TokenName   types.String   `tfsdk:"token_name"`
+	Type        types.String   `tfsdk:"type"`
+	Environment types.String   `tfsdk:"environment"`
+	Projects    []types.String `tfsdk:"projects"`
+	ExpiresAt   types.String   `tfsdk:"expires_at"`
+	Secret      types.String   `tfsdk:"secret"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+}
+
+func createApiTokenResourceSchemaAttr() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"token_name": schema.StringAttribute{
+			Description: "The name of this token. Changing it creates a new token.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"type": schema.StringAttribute{
+			Description: "Type of this token (client, frontend, admin). Changing it creates a new token.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"environment": schema.StringAttribute{
+			Description: "The environment this token has access to. Unleash uses default for client and frontend tokens and * for admin tokens if it is not specified. Changing it creates a new token.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"projects": schema.SetAttribute{
+			Description: "The projects this token has access to. Unleash gives access to all projects if it is not specified. Changing it creates a new token.",
+			Optional:    true,
+			ElementType: types.StringType,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.RequiresReplace(),
+			},
+		},
+		"expires_at": schema.StringAttribute{
+			Description: "The time when this token expires in RFC 3339 format e.g. 2030-01-02T15:04:05Z. The token never expires if it is not specified. Removing it creates a new token because Unleash cannot clear the expiry of a token.",
+			Optional:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIf(requiresReplaceIfExpiryRemoved,
+					"Removing the expiry requires a new token", "Removing the expiry requires a new token"),
+			},
+		},
+		"secret": schema.StringAttribute{
+			Description: "The generated token which is used for authentication",
+			Computed:    true,
+			Sensitive:   true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The time when this token was created",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func requiresReplaceIfExpiryRemoved(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = req.PlanValue.IsNull() && !req.StateValue.IsNull()
+}
+
+func toApiTokenModel(apiToken unleash.ApiTokenSchema) ApiTokenModel {
+	apiTokenModel := ApiTokenModel{
+		TokenName: types.StringValue(apiToken.TokenName),
+		Type:      types.StringValue(string(apiToken.Type)),
+		Secret:    types.StringValue(apiToken.Secret),
+		CreatedAt: types.StringValue(apiToken.CreatedAt.Format(time.RFC3339)),
+	}
+	if apiToken.Environment != nil {
+		apiTokenModel.Environment = types.StringValue(*apiToken.Environment)
+	}
+	for _, project := range apiToken.Projects {
+		apiTokenModel.Projects = append(apiTokenModel.Projects, types.StringValue(project))
+	}
+	if apiToken.ExpiresAt != nil {
+		apiTokenModel.ExpiresAt = types.StringValue(apiToken.ExpiresAt.Format(time.RFC3339))
+	}
+
+	return apiTokenModel
+}
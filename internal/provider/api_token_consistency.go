@@ -0,0 +1,14 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+func ensureApiTokenModelNullAndEmptyConsistency(apiTokenModel *ApiTokenModel, apiTokenModelBefore ApiTokenModel) {
+	if apiTokenModelBefore.Projects == nil && !apiTokenModelBefore.TokenName.IsNull() &&
+		len(apiTokenModel.Projects) == 1 && apiTokenModel.Projects[0].ValueString() == "*" {
+		// access to all projects is the default when projects are not specified
+		apiTokenModel.Projects = nil
+	}
+	tryKeepSameInstant(apiTokenModel.ExpiresAt, apiTokenModelBefore.ExpiresAt, func(value types.String) {
+		apiTokenModel.ExpiresAt = value
+	})
+}
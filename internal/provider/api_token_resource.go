@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+var _ resource.Resource = &ApiTokenResource{}
+var _ resource.ResourceWithImportState = &ApiTokenResource{}
+
+func NewApiTokenResource() resource.Resource {
+	return &ApiTokenResource{}
+}
+
+type ApiTokenResource struct {
+	providerData UnleashProviderData
+}
+
+type ApiTokenResourceModel struct {
+	ApiTokenModel
+}
+
+func (r *ApiTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *ApiTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "API token resource. It can be imported by its secret.",
+
+		Attributes: createApiTokenResourceSchemaAttr(),
+	}
+}
+
+func (r *ApiTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *ApiTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ApiTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := toCreateApiTokenBody(data)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create api token "+data.TokenName.String(), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Creating api token", map[string]interface{}{"tokenName": data.TokenName.ValueString()})
+	createResp, err := r.providerData.Client.CreateApiTokenWithResponse(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create api token "+data.TokenName.String(), err.Error())
+		return
+	}
+	if createResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to create api token "+data.TokenName.String(), fmt.Sprintf(" with status %d %s", createResp.StatusCode(), string(createResp.Body)))
+		return
+	}
+	apiTokenModel := toApiTokenModel(*createResp.JSON201)
+	ensureApiTokenModelNullAndEmptyConsistency(&apiTokenModel, data.ApiTokenModel)
+	data.Environment = apiTokenModel.Environment
+	data.Secret = apiTokenModel.Secret
+	data.CreatedAt = apiTokenModel.CreatedAt
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func toCreateApiTokenBody(data ApiTokenResourceModel) (unleash.CreateApiTokenJSONRequestBody, error) {
+	var body unleash.CreateApiTokenJSONRequestBody
+
+	var expiresAt *time.Time
+	if !data.ExpiresAt.IsNull() {
+		parsed, err := time.Parse(time.RFC3339, data.ExpiresAt.ValueString())
+		if err != nil {
+			return body, fmt.Errorf("invalid expires_at %s: %w", data.ExpiresAt.ValueString(), err)
+		}
+		expiresAt = &parsed
+	}
+
+	if strings.EqualFold(data.Type.ValueString(), string(unleash.ApiTokenSchemaTypeAdmin)) {
+		if !data.Environment.IsNull() && !data.Environment.IsUnknown() || data.Projects != nil {
+			return body, fmt.Errorf("environment and projects are not supported by admin tokens")
+		}
+		err := body.FromCreateApiTokenSchema0(unleash.CreateApiTokenSchema0{
+			TokenName: data.TokenName.ValueString(),
+			Type:      data.Type.ValueString(),
+			ExpiresAt: expiresAt,
+		})
+
+		return body, err
+	}
+
+	tokenBody := unleash.CreateApiTokenSchema2{
+		TokenName: data.TokenName.ValueString(),
+		Type:      data.Type.ValueString(),
+		ExpiresAt: expiresAt,
+	}
+	if !data.Environment.IsNull() && !data.Environment.IsUnknown() {
+		tokenBody.Environment = data.Environment.ValueStringPointer()
+	}
+	if data.Projects != nil {
+		projects := toStringValueSlice(data.Projects)
+		tokenBody.Projects = &projects
+	}
+	err := body.FromCreateApiTokenSchema2(tokenBody)
+
+	return body, err
+}
+
+func (r *ApiTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ApiTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading api token", map[string]interface{}{"tokenName": data.TokenName.ValueString()})
+	readResp, err := r.providerData.Client.GetAllApiTokensWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get api tokens", err.Error())
+		return
+	}
+	if readResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to read api token "+data.TokenName.String(), fmt.Sprintf(" with status %d %s", readResp.StatusCode(), string(readResp.Body)))
+		return
+	}
+	var apiToken *unleash.ApiTokenSchema
+	for _, token := range readResp.JSON200.Tokens {
+		if token.Secret == data.Secret.ValueString() {
+			apiToken = &token
+			break
+		}
+	}
+	if apiToken == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	apiTokenModel := toApiTokenModel(*apiToken)
+	ensureApiTokenModelNullAndEmptyConsistency(&apiTokenModel, data.ApiTokenModel)
+	data.ApiTokenModel = apiTokenModel
+
+	tflog.Trace(ctx, "read resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ApiTokenResourceModel
+	var existingData ApiTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &existingData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// expires_at is the only attribute which can be updated, others require replacement
+	if !data.ExpiresAt.IsNull() && !data.ExpiresAt.Equal(existingData.ExpiresAt) {
+		expiresAt, err := time.Parse(time.RFC3339, data.ExpiresAt.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("failed to update api token "+data.TokenName.String(), fmt.Sprintf("invalid expires_at %s: %s", data.ExpiresAt.ValueString(), err.Error()))
+			return
+		}
+		body := unleash.UpdateApiTokenJSONRequestBody{
+			ExpiresAt: expiresAt,
+		}
+		tflog.Debug(ctx, "Updating api token", map[string]interface{}{
+			"tokenName": data.TokenName.ValueString(),
+			"body":      body,
+		})
+		updateResp, err := r.providerData.Client.UpdateApiTokenWithResponse(ctx, existingData.Secret.ValueString(), body)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to update api token "+data.TokenName.String(), err.Error())
+			return
+		}
+		if updateResp.StatusCode() > 299 {
+			resp.Diagnostics.AddError("failed to update api token "+data.TokenName.String(), fmt.Sprintf(" with status %d %s", updateResp.StatusCode(), string(updateResp.Body)))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ApiTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting api token", map[string]interface{}{"tokenName": data.TokenName.ValueString()})
+	deleteResp, err := r.providerData.Client.DeleteApiTokenWithResponse(ctx, data.Secret.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete api token "+data.TokenName.String(), err.Error())
+		return
+	}
+	if deleteResp.StatusCode() > 299 && deleteResp.StatusCode() != 404 {
+		resp.Diagnostics.AddError("failed to delete api token "+data.TokenName.String(), fmt.Sprintf(" with status %d %s", deleteResp.StatusCode(), string(deleteResp.Body)))
+		return
+	}
+}
+
+func (r *ApiTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("secret"), req, resp)
+}
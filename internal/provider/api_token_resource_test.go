@@ -0,0 +1,101 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestAccApiTokenResource(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+
+	var secret string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_api_token" "client" {
+	token_name = "my-service"
+	type = "client"
+	environment = "development"
+	projects = ["default"]
+	expires_at = "2030-01-01T00:00:00Z"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_api_token.client", "token_name", "my-service"),
+					resource.TestCheckResourceAttr("unleash_api_token.client", "type", "client"),
+					resource.TestCheckResourceAttr("unleash_api_token.client", "environment", "development"),
+					resource.TestCheckResourceAttr("unleash_api_token.client", "projects.#", "1"),
+					resource.TestCheckTypeSetElemAttr("unleash_api_token.client", "projects.*", "default"),
+					resource.TestCheckResourceAttr("unleash_api_token.client", "expires_at", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrSet("unleash_api_token.client", "secret"),
+					resource.TestCheckResourceAttrSet("unleash_api_token.client", "created_at"),
+					resource.TestCheckResourceAttrWith("unleash_api_token.client", "secret", func(value string) error {
+						secret = value
+						return nil
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "unleash_api_token.client",
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["unleash_api_token.client"].Primary.Attributes["secret"], nil
+				},
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "secret",
+			},
+			//	Update and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_api_token" "client" {
+	token_name = "my-service"
+	type = "client"
+	environment = "development"
+	projects = ["default"]
+	expires_at = "2031-01-01T00:00:00Z"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_api_token.client", "expires_at", "2031-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrWith("unleash_api_token.client", "secret", func(value string) error {
+						if value != secret {
+							return fmt.Errorf("expected the token to be updated in place but the secret changed")
+						}
+						return nil
+					}),
+				),
+			},
+			//	Replace testing
+			{
+				Config: providerConf + `
+resource "unleash_api_token" "client" {
+	token_name = "my-service"
+	type = "frontend"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_api_token.client", "type", "frontend"),
+					resource.TestCheckResourceAttr("unleash_api_token.client", "environment", "default"),
+					resource.TestCheckNoResourceAttr("unleash_api_token.client", "projects"),
+					resource.TestCheckNoResourceAttr("unleash_api_token.client", "expires_at"),
+					resource.TestCheckResourceAttrWith("unleash_api_token.client", "secret", func(value string) error {
+						if value == secret {
+							return fmt.Errorf("expected a new token to be created")
+						}
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -1,6 +1,10 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 func tryUpdateToEmptyStringIfBeforeEmpty(value types.String, valueBefore types.String, setterFn func(types.String)) {
 	if value.IsNull() && !valueBefore.IsNull() && len(valueBefore.ValueString()) == 0 {
@@ -14,6 +18,24 @@ func tryUpdateToFalseIfBeforeFalse(value types.Bool, valueBefore types.Bool, set
 	}
 }
 
+// tryKeepSameInstant keeps the representation of an RFC 3339 time before if both are the same instant e.g. different time zones.
+func tryKeepSameInstant(value types.String, valueBefore types.String, setterFn func(types.String)) {
+	if value.IsNull() || valueBefore.IsNull() {
+		return
+	}
+	instant, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		return
+	}
+	instantBefore, err := time.Parse(time.RFC3339, valueBefore.ValueString())
+	if err != nil {
+		return
+	}
+	if instant.Equal(instantBefore) {
+		setterFn(valueBefore)
+	}
+}
+
 func isNullArrayAndExistingEmptyArray[T any](current []T, before []T) bool {
 	return current == nil && before != nil && len(before) == 0
 }
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+type ContextFieldModel struct {
+	Name        types.String      `tfsdk:"name"`
+	Description types.String      `tfsdk:"description"`
+	Stickiness  types.Bool        `tfsdk:"stickiness"`
+	SortOrder   types.Int64       `tfsdk:"sort_order"`
+	LegalValues []LegalValueModel `tfsdk:"legal_values"`
+}
+
+type LegalValueModel struct {
+	Value       types.String `tfsdk:"value"`
+	Description types.String `tfsdk:"description"`
+}
+
+func createContextFieldResourceSchemaAttr() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Description: "The name of this context field. This cannot be changed after the context field is created.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"description": schema.StringAttribute{
+			Description: "A description of this context field",
+			Optional:    true,
+		},
+		"stickiness": schema.BoolAttribute{
+			Description: "true if this context field can be used for custom stickiness, otherwise false",
+			Optional:    true,
+		},
+		"sort_order": schema.Int64Attribute{
+			Description: "How this context field should be sorted if no other sort order is selected",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"legal_values": schema.ListNestedAttribute{
+			Description: "The list of allowed values for this context field",
+			Optional:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: createLegalValueResourceSchemaAttrs(),
+			},
+		},
+	}
+}
+
+func createLegalValueResourceSchemaAttrs() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"value": schema.StringAttribute{
+			Description: "The allowed value",
+			Required:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "A description of this allowed value",
+			Optional:    true,
+		},
+	}
+}
+
+func toContextFieldModel(contextField *unleash.ContextFieldSchema) ContextFieldModel {
+	contextFieldModel := ContextFieldModel{
+		Name: types.StringValue(contextField.Name),
+	}
+	if contextField.Description != nil && *contextField.Description != "" {
+		contextFieldModel.Description = types.StringValue(*contextField.Description)
+	}
+	if contextField.Stickiness != nil {
+		contextFieldModel.Stickiness = types.BoolValue(*contextField.Stickiness)
+	}
+	if contextField.SortOrder != nil {
+		contextFieldModel.SortOrder = types.Int64Value(int64(*contextField.SortOrder))
+	}
+	if contextField.LegalValues != nil && len(*contextField.LegalValues) > 0 {
+		contextFieldModel.LegalValues = make([]LegalValueModel, len(*contextField.LegalValues))
+		for i, legalValue := range *contextField.LegalValues {
+			legalValueModel := LegalValueModel{
+				Value: types.StringValue(legalValue.Value),
+			}
+			if legalValue.Description != nil && *legalValue.Description != "" {
+				legalValueModel.Description = types.StringValue(*legalValue.Description)
+			}
+			contextFieldModel.LegalValues[i] = legalValueModel
+		}
+	}
+
+	return contextFieldModel
+}
@@ -0,0 +1,23 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+func ensureContextFieldModelNullAndEmptyConsistency(contextFieldModel *ContextFieldModel, contextFieldModelBefore ContextFieldModel) {
+	tryUpdateToEmptyStringIfBeforeEmpty(contextFieldModel.Description, contextFieldModelBefore.Description, func(value types.String) {
+		contextFieldModel.Description = value
+	})
+	// Unleash always returns the stickiness flag, keep it null when it was not configured and is still the default
+	if contextFieldModelBefore.Stickiness.IsNull() && !contextFieldModel.Stickiness.ValueBool() {
+		contextFieldModel.Stickiness = types.BoolNull()
+	}
+	if isNullArrayAndExistingEmptyArray(contextFieldModel.LegalValues, contextFieldModelBefore.LegalValues) {
+		contextFieldModel.LegalValues = []LegalValueModel{}
+	} else if len(contextFieldModel.LegalValues) == len(contextFieldModelBefore.LegalValues) {
+		for i := range contextFieldModel.LegalValues {
+			legalValue := &contextFieldModel.LegalValues[i]
+			tryUpdateToEmptyStringIfBeforeEmpty(legalValue.Description, contextFieldModelBefore.LegalValues[i].Description, func(value types.String) {
+				legalValue.Description = value
+			})
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+var _ resource.Resource = &ContextFieldResource{}
+var _ resource.ResourceWithImportState = &ContextFieldResource{}
+
+func NewContextFieldResource() resource.Resource {
+	return &ContextFieldResource{}
+}
+
+type ContextFieldResource struct {
+	providerData UnleashProviderData
+}
+
+type ContextFieldResourceModel struct {
+	ContextFieldModel
+}
+
+func (r *ContextFieldResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_context_field"
+}
+
+func (r *ContextFieldResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Context field resource",
+
+		Attributes: createContextFieldResourceSchemaAttr(),
+	}
+}
+
+func (r *ContextFieldResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *ContextFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ContextFieldResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateBody := toContextFieldBody(data)
+	body := unleash.CreateContextFieldJSONRequestBody{
+		Name:        data.Name.ValueString(),
+		Description: updateBody.Description,
+		LegalValues: updateBody.LegalValues,
+		SortOrder:   updateBody.SortOrder,
+		Stickiness:  updateBody.Stickiness,
+	}
+
+	tflog.Debug(ctx, "Creating context field", map[string]interface{}{"body": body})
+	createResp, err := r.providerData.Client.CreateContextFieldWithResponse(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create context field "+data.Name.String(), err.Error())
+		return
+	}
+	if createResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to create context field "+data.Name.String(), fmt.Sprintf(" with status %d %s", createResp.StatusCode(), string(createResp.Body)))
+		return
+	}
+	if data.SortOrder.IsUnknown() {
+		data.SortOrder = types.Int64Null()
+		if createResp.JSON201 != nil && createResp.JSON201.SortOrder != nil {
+			data.SortOrder = types.Int64Value(int64(*createResp.JSON201.SortOrder))
+		}
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ContextFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ContextFieldResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading context field", map[string]interface{}{"name": data.Name.ValueString()})
+	readResp, err := r.providerData.Client.GetContextFieldWithResponse(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get context field", err.Error())
+		return
+	}
+	if readResp.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if readResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to read context field "+data.Name.String(), fmt.Sprintf(" with status %d %s", readResp.StatusCode(), string(readResp.Body)))
+		return
+	}
+	contextFieldModel := toContextFieldModel(readResp.JSON200)
+	ensureContextFieldModelNullAndEmptyConsistency(&contextFieldModel, data.ContextFieldModel)
+	data.ContextFieldModel = contextFieldModel
+
+	tflog.Trace(ctx, "read resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ContextFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ContextFieldResourceModel
+	var existingData ContextFieldResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &existingData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contextFieldBody := toContextFieldBody(data)
+	existingContextFieldBody := toContextFieldBody(existingData)
+	if !cmp.Equal(contextFieldBody, existingContextFieldBody) {
+		tflog.Debug(ctx, "Updating context field", map[string]interface{}{
+			"name": data.Name.ValueString(),
+			"body": contextFieldBody,
+		})
+		updateResp, err := r.providerData.Client.UpdateContextFieldWithResponse(ctx, data.Name.ValueString(), contextFieldBody)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to update context field "+data.Name.String(), err.Error())
+			return
+		}
+		if updateResp.StatusCode() > 299 {
+			resp.Diagnostics.AddError("failed to update context field "+data.Name.String(), fmt.Sprintf(" with status %d %s", updateResp.StatusCode(), string(updateResp.Body)))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func toContextFieldBody(data ContextFieldResourceModel) unleash.UpdateContextFieldJSONRequestBody {
+	body := unleash.UpdateContextFieldJSONRequestBody{
+		Description: data.Description.ValueStringPointer(),
+		Stickiness:  data.Stickiness.ValueBoolPointer(),
+	}
+	if body.Description == nil {
+		body.Description = ptr.ToPtr("")
+	}
+	if body.Stickiness == nil {
+		body.Stickiness = ptr.ToPtr(false)
+	}
+	if !data.SortOrder.IsNull() && !data.SortOrder.IsUnknown() {
+		body.SortOrder = ptr.ToPtr(int(data.SortOrder.ValueInt64()))
+	}
+	legalValues := make([]unleash.LegalValueSchema, len(data.LegalValues))
+	for i, legalValue := range data.LegalValues {
+		legalValues[i] = unleash.LegalValueSchema{
+			Value:       legalValue.Value.ValueString(),
+			Description: legalValue.Description.ValueStringPointer(),
+		}
+	}
+	body.LegalValues = &legalValues
+
+	return body
+}
+
+func (r *ContextFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ContextFieldResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting context field", map[string]interface{}{"name": data.Name.ValueString()})
+	deleteResp, err := r.providerData.Client.DeleteContextFieldWithResponse(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete context field "+data.Name.String(), err.Error())
+		return
+	}
+	if deleteResp.StatusCode() > 299 && deleteResp.StatusCode() != 404 {
+		resp.Diagnostics.AddError("failed to delete context field "+data.Name.String(), fmt.Sprintf(" with status %d %s", deleteResp.StatusCode(), string(deleteResp.Body)))
+		return
+	}
+}
+
+func (r *ContextFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
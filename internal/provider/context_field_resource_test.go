@@ -0,0 +1,75 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestAccContextFieldResource(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_context_field" "tenant_id" {
+	name = "tenantId"
+	description = "desc tenantId"
+	stickiness = true
+	legal_values = [{
+			value = "tenant1"
+			description = "the first tenant"
+		},
+		{
+			value = "tenant2"
+		},
+	]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_context_field.tenant_id", "name", "tenantId"),
+					resource.TestCheckResourceAttr("unleash_context_field.tenant_id", "description", "desc tenantId"),
+					resource.TestCheckResourceAttr("unleash_context_field.tenant_id", "stickiness", "true"),
+					resource.TestCheckResourceAttr("unleash_context_field.tenant_id", "sort_order", "10"),
+					resource.TestCheckResourceAttr("unleash_context_field.tenant_id", "legal_values.#", "2"),
+					resource.TestCheckResourceAttr("unleash_context_field.tenant_id", "legal_values.0.description", "the first tenant"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "unleash_context_field.tenant_id",
+				ImportStateId:                        "tenantId",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			//	Update and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_context_field" "tenant_id" {
+	name = "tenantId"
+	sort_order = 2
+	legal_values = [{
+			value = "tenant3"
+		},
+	]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_context_field.tenant_id", "name", "tenantId"),
+					resource.TestCheckNoResourceAttr("unleash_context_field.tenant_id", "description"),
+					resource.TestCheckNoResourceAttr("unleash_context_field.tenant_id", "stickiness"),
+					resource.TestCheckResourceAttr("unleash_context_field.tenant_id", "sort_order", "2"),
+					resource.TestCheckResourceAttr("unleash_context_field.tenant_id", "legal_values.#", "1"),
+					resource.TestCheckResourceAttr("unleash_context_field.tenant_id", "legal_values.0.value", "tenant3"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+// GlobalEnvironmentModel is an Unleash instance wide environment. It is not named EnvironmentModel because
+// that name is already used by the environments of a feature.
+type GlobalEnvironmentModel struct {
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	SortOrder types.Int64  `tfsdk:"sort_order"`
+	Protected types.Bool   `tfsdk:"protected"`
+}
+
+func createGlobalEnvironmentResourceSchemaAttr() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Description: "The name of this environment. This cannot be changed after the environment is created.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"type": schema.StringAttribute{
+			Description: "Type of this environment e.g. development, test, preproduction, production",
+			Required:    true,
+		},
+		"enabled": schema.BoolAttribute{
+			Description: "true if this environment is enabled, otherwise false. Unleash enables a new environment if it is not specified.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"sort_order": schema.Int64Attribute{
+			Description: "Where this environment is placed in the list of environments. Lower numbers are shown first.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"protected": schema.BoolAttribute{
+			Description: "true if this environment is protected by Unleash and cannot be changed or deleted, otherwise false",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func toGlobalEnvironmentModel(environment *unleash.EnvironmentSchema) GlobalEnvironmentModel {
+	return GlobalEnvironmentModel{
+		Name:      types.StringValue(environment.Name),
+		Type:      types.StringValue(environment.Type),
+		Enabled:   types.BoolValue(environment.Enabled),
+		SortOrder: types.Int64Value(int64(environment.SortOrder)),
+		Protected: types.BoolValue(environment.Protected),
+	}
+}
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+var _ resource.Resource = &EnvironmentResource{}
+var _ resource.ResourceWithImportState = &EnvironmentResource{}
+
+func NewEnvironmentResource() resource.Resource {
+	return &EnvironmentResource{}
+}
+
+type EnvironmentResource struct {
+	providerData UnleashProviderData
+}
+
+type EnvironmentResourceModel struct {
+	GlobalEnvironmentModel
+}
+
+func (r *EnvironmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment"
+}
+
+func (r *EnvironmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Environment resource",
+
+		Attributes: createGlobalEnvironmentResourceSchemaAttr(),
+	}
+}
+
+func (r *EnvironmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *EnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := unleash.CreateEnvironmentJSONRequestBody{
+		Name: data.Name.ValueString(),
+		Type: data.Type.ValueString(),
+	}
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
+		body.Enabled = data.Enabled.ValueBoolPointer()
+	}
+	if !data.SortOrder.IsNull() && !data.SortOrder.IsUnknown() {
+		body.SortOrder = ptr.ToPtr(int(data.SortOrder.ValueInt64()))
+	}
+
+	tflog.Debug(ctx, "Creating environment", map[string]interface{}{"body": body})
+	createResp, err := r.providerData.Client.CreateEnvironmentWithResponse(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create environment "+data.Name.String(), err.Error())
+		return
+	}
+	if createResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to create environment "+data.Name.String(), fmt.Sprintf(" with status %d %s", createResp.StatusCode(), string(createResp.Body)))
+		return
+	}
+	data.GlobalEnvironmentModel = toGlobalEnvironmentModel(createResp.JSON201)
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading environment", map[string]interface{}{"name": data.Name.ValueString()})
+	readResp, err := r.providerData.Client.GetEnvironmentWithResponse(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get environment", err.Error())
+		return
+	}
+	if readResp.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if readResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to read environment "+data.Name.String(), fmt.Sprintf(" with status %d %s", readResp.StatusCode(), string(readResp.Body)))
+		return
+	}
+	data.GlobalEnvironmentModel = toGlobalEnvironmentModel(readResp.JSON200)
+
+	tflog.Trace(ctx, "read resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data EnvironmentResourceModel
+	var existingData EnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &existingData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentBody := toEnvironmentBody(data)
+	existingEnvironmentBody := toEnvironmentBody(existingData)
+	if !cmp.Equal(environmentBody, existingEnvironmentBody) {
+		tflog.Debug(ctx, "Updating environment", map[string]interface{}{
+			"name": data.Name.ValueString(),
+			"body": environmentBody,
+		})
+		updateResp, err := r.providerData.Client.UpdateEnvironmentWithResponse(ctx, data.Name.ValueString(), environmentBody)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to update environment "+data.Name.String(), err.Error())
+			return
+		}
+		if updateResp.StatusCode() > 299 {
+			resp.Diagnostics.AddError("failed to update environment "+data.Name.String(), fmt.Sprintf(" with status %d %s", updateResp.StatusCode(), string(updateResp.Body)))
+			return
+		}
+	}
+
+	if !data.SortOrder.IsNull() && !data.SortOrder.IsUnknown() && !data.SortOrder.Equal(existingData.SortOrder) {
+		err := r.updateSortOrder(ctx, data.Name.ValueString(), int(data.SortOrder.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError("failed to update environment "+data.Name.String(), err.Error())
+			return
+		}
+	}
+
+	if !data.Enabled.Equal(existingData.Enabled) {
+		err := r.updateEnabled(ctx, data.Name.ValueString(), data.Enabled.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("failed to update environment "+data.Name.String(), err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// toEnvironmentBody omits the sort order which is updated by updateSortOrder.
+func toEnvironmentBody(data EnvironmentResourceModel) unleash.UpdateEnvironmentJSONRequestBody {
+	return unleash.UpdateEnvironmentJSONRequestBody{
+		Type: data.Type.ValueStringPointer(),
+	}
+}
+
+func (r *EnvironmentResource) updateSortOrder(ctx context.Context, name string, sortOrder int) error {
+	tflog.Debug(ctx, "Updating environment sort order", map[string]interface{}{
+		"name":      name,
+		"sortOrder": sortOrder,
+	})
+	resp, err := r.providerData.Client.UpdateSortOrderWithResponse(ctx, unleash.UpdateSortOrderJSONRequestBody{name: sortOrder})
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() > 299 {
+		return fmt.Errorf("failed to update sort order of environment %s with status %d %s", name, resp.StatusCode(), string(resp.Body))
+	}
+
+	return nil
+}
+
+func (r *EnvironmentResource) updateEnabled(ctx context.Context, name string, enabled bool) error {
+	tflog.Debug(ctx, "Toggling environment", map[string]interface{}{
+		"name":    name,
+		"enabled": enabled,
+	})
+	if enabled {
+		resp, err := r.providerData.Client.ToggleEnvironmentOnWithResponse(ctx, name)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode() > 299 {
+			return fmt.Errorf("failed to enable environment %s with status %d %s", name, resp.StatusCode(), string(resp.Body))
+		}
+		return nil
+	}
+
+	resp, err := r.providerData.Client.ToggleEnvironmentOffWithResponse(ctx, name)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() > 299 {
+		return fmt.Errorf("failed to disable environment %s with status %d %s", name, resp.StatusCode(), string(resp.Body))
+	}
+
+	return nil
+}
+
+func (r *EnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting environment", map[string]interface{}{"name": data.Name.ValueString()})
+	deleteResp, err := r.providerData.Client.RemoveEnvironmentWithResponse(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete environment "+data.Name.String(), err.Error())
+		return
+	}
+	if deleteResp.StatusCode() > 299 && deleteResp.StatusCode() != 404 {
+		resp.Diagnostics.AddError("failed to delete environment "+data.Name.String(), fmt.Sprintf(" with status %d %s", deleteResp.StatusCode(), string(deleteResp.Body)))
+		return
+	}
+}
+
+func (r *EnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,62 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestAccEnvironmentResource(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_environment" "staging" {
+	name = "staging"
+	type = "test"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_environment.staging", "name", "staging"),
+					resource.TestCheckResourceAttr("unleash_environment.staging", "type", "test"),
+					resource.TestCheckResourceAttr("unleash_environment.staging", "enabled", "true"),
+					resource.TestCheckResourceAttr("unleash_environment.staging", "sort_order", "9999"),
+					resource.TestCheckResourceAttr("unleash_environment.staging", "protected", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "unleash_environment.staging",
+				ImportStateId:                        "staging",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			//	Update and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_environment" "staging" {
+	name = "staging"
+	type = "preproduction"
+	enabled = false
+	sort_order = 150
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_environment.staging", "name", "staging"),
+					resource.TestCheckResourceAttr("unleash_environment.staging", "type", "preproduction"),
+					resource.TestCheckResourceAttr("unleash_environment.staging", "enabled", "false"),
+					resource.TestCheckResourceAttr("unleash_environment.staging", "sort_order", "150"),
+					resource.TestCheckResourceAttr("unleash_environment.staging", "protected", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
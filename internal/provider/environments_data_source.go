@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &EnvironmentsDataSource{}
+
+func NewEnvironmentsDataSource() datasource.DataSource {
+	return &EnvironmentsDataSource{}
+}
+
+type EnvironmentsDataSource struct {
+	providerData UnleashProviderData
+}
+
+type EnvironmentsDataSourceModel struct {
+	Environments []GlobalEnvironmentModel `tfsdk:"environments"`
+}
+
+func (d *EnvironmentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environments"
+}
+
+func (d *EnvironmentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Environments data source",
+
+		Attributes: map[string]schema.Attribute{
+			"environments": schema.ListNestedAttribute{
+				Description: "All environments of the Unleash instance in their sort order",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of this environment",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of this environment e.g. development, production",
+							Computed:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "true if this environment is enabled, otherwise false",
+							Computed:    true,
+						},
+						"sort_order": schema.Int64Attribute{
+							Description: "The sort order of this environment",
+							Computed:    true,
+						},
+						"protected": schema.BoolAttribute{
+							Description: "true if this environment is protected, otherwise false",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EnvironmentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *EnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EnvironmentsDataSourceModel
+
+	tflog.Debug(ctx, "Reading environments")
+	readResp, err := d.providerData.Client.GetAllEnvironmentsWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get environments", err.Error())
+		return
+	}
+	if readResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to get environments", fmt.Sprintf(" with status %d %s", readResp.StatusCode(), string(readResp.Body)))
+		return
+	}
+	data.Environments = make([]GlobalEnvironmentModel, 0, len(readResp.JSON200.Environments))
+	for _, environment := range readResp.JSON200.Environments {
+		data.Environments = append(data.Environments, toGlobalEnvironmentModel(&environment))
+	}
+
+	tflog.Trace(ctx, "read data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,30 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestAccEnvironmentsDataSource(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConf + `
+data "unleash_environments" "all" {
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unleash_environments.all", "environments.#", "2"),
+					resource.TestCheckResourceAttr("data.unleash_environments.all", "environments.0.name", "development"),
+					resource.TestCheckResourceAttr("data.unleash_environments.all", "environments.1.name", "production"),
+				),
+			},
+		},
+	})
+}
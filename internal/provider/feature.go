@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -20,13 +21,29 @@ type FeatureModel struct {
 	Type           types.String                `tfsdk:"type"`
 	Description    types.String                `tfsdk:"description"`
 	ImpressionData types.Bool                  `tfsdk:"impression_data"`
+	Stale          types.Bool                  `tfsdk:"stale"`
 	Environments   map[string]EnvironmentModel `tfsdk:"environments"`
+	Dependencies   []FeatureDependencyModel    `tfsdk:"dependencies"`
+	Tags           []FeatureTagModel           `tfsdk:"tags"`
+}
+
+type FeatureTagModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+type FeatureDependencyModel struct {
+	Feature  types.String   `tfsdk:"feature"`
+	Enabled  types.Bool     `tfsdk:"enabled"`
+	Variants []types.String `tfsdk:"variants"`
 }
 
 type EnvironmentModel struct {
-	Enabled    types.Bool      `tfsdk:"enabled"`
-	Strategies []StrategyModel `tfsdk:"strategies"`
-	Variants   []VariantModel  `tfsdk:"variants"`
+	Enabled          types.Bool      `tfsdk:"enabled"`
+	ManageEnabled    types.Bool      `tfsdk:"manage_enabled"`
+	ManageStrategies types.Bool      `tfsdk:"manage_strategies"`
+	Strategies       []StrategyModel `tfsdk:"strategies"`
+	Variants         []VariantModel  `tfsdk:"variants"`
 }
 
 type VariantModel struct {
@@ -94,6 +111,14 @@ func createFeatureResourceSchemaAttr() map[string]schema.Attribute {
 			Description: "true if the impression data collection is enabled for the feature, otherwise false",
 			Optional:    true,
 		},
+		"stale": schema.BoolAttribute{
+			Description: "true if the feature is marked as stale, otherwise false. The stale flag is left as it is in Unleash if this is not specified.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
 		"environments": schema.MapNestedAttribute{
 			Description: "The list of environments where the feature can be used",
 			Required:    true,
@@ -101,15 +126,67 @@ func createFeatureResourceSchemaAttr() map[string]schema.Attribute {
 				Attributes: createEnvironmentResourceSchemaAttrs(),
 			},
 		},
+		"dependencies": schema.SetNestedAttribute{
+			Description: "Parent features which must be enabled for this feature to be enabled. " +
+				"Dependencies are left as they are in Unleash if this is not specified, set it to empty to remove all of them",
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: createFeatureDependencyResourceSchemaAttrs(),
+			},
+		},
+		"tags": schema.SetNestedAttribute{
+			Description: "Tags of the feature e.g. team:payments. " +
+				"Tags are left as they are in Unleash if this is not specified, set it to empty to remove all of them",
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: createFeatureTagResourceSchemaAttrs(),
+			},
+		},
 	}
 }
 
-func createEnvironmentResourceSchemaAttrs() map[string]schema.Attribute {
+func createFeatureDependencyResourceSchemaAttrs() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
+		"feature": schema.StringAttribute{
+			Description: "The name of the parent feature in the same project",
+			Required:    true,
+		},
 		"enabled": schema.BoolAttribute{
-			Description: "Is this environment enabled",
+			Description: "true if the parent feature must be enabled, false if it must be disabled. Unleash uses true if it is not specified.",
+			Optional:    true,
+		},
+		"variants": schema.SetAttribute{
+			Description: "Variants which the parent feature must resolve to. This is only valid when enabled is true.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func createFeatureTagResourceSchemaAttrs() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			Description: "The name of the tag type e.g. simple",
 			Required:    true,
 		},
+		"value": schema.StringAttribute{
+			Description: "The value of the tag",
+			Required:    true,
+		},
+	}
+}
+
+func createEnvironmentResourceSchemaAttrs() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"enabled": schema.BoolAttribute{
+			Description: "Is this environment enabled. This is required unless manage_enabled is false",
+			Optional:    true,
+		},
+		"manage_enabled": schema.BoolAttribute{
+			Description: "false to leave whether this environment is enabled to other resources e.g. unleash_feature_environment. " +
+				"enabled must not be specified then. The enabled flag is managed if this is not specified.",
+			Optional: true,
+		},
 		"variants": schema.ListNestedAttribute{
 			Description: "Variants of this feature",
 			NestedObject: schema.NestedAttributeObject{
@@ -117,12 +194,17 @@ func createEnvironmentResourceSchemaAttrs() map[string]schema.Attribute {
 			},
 			Optional: true,
 		},
+		"manage_strategies": schema.BoolAttribute{
+			Description: "false to leave the strategies of this environment to other resources e.g. unleash_feature_strategy. " +
+				"strategies must not be specified then. The strategies are managed if this is not specified.",
+			Optional: true,
+		},
 		"strategies": schema.ListNestedAttribute{
-			Description: "Strategies of this feature",
+			Description: "Strategies of this feature. This is required unless manage_strategies is false",
 			NestedObject: schema.NestedAttributeObject{
-				Attributes: createStrategyResourceSchemaAttrs(),
+				Attributes: withSegmentsOfFeatureProject(createStrategyResourceSchemaAttrs()),
 			},
-			Required: true,
+			Optional: true,
 		},
 	}
 }
@@ -176,6 +258,17 @@ func createVariantOverrideResourceSchemaAttrs() map[string]schema.Attribute {
 	}
 }
 
+// withSegmentsOfFeatureProject describes the segments of strategies of features, which are validated at plan time.
+func withSegmentsOfFeatureProject(attrs map[string]schema.Attribute) map[string]schema.Attribute {
+	attrs["segments"] = schema.SetAttribute{
+		Description: "Segment IDs of this strategy. The segments must be global or belong to the project of the feature",
+		Optional:    true,
+		ElementType: types.Float32Type,
+	}
+
+	return attrs
+}
+
 func createStrategyResourceSchemaAttrs() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"id": schema.StringAttribute{
@@ -272,6 +365,7 @@ func toFeatureModel(fetchedFeature unleash.FetchedFeature) (FeatureModel, error)
 	if fetchedFeature.Feature.ImpressionData != nil {
 		f.ImpressionData = types.BoolValue(*fetchedFeature.Feature.ImpressionData)
 	}
+	f.Stale = types.BoolValue(fetchedFeature.Feature.Stale != nil && *fetchedFeature.Feature.Stale)
 	if len(fetchedFeature.FetchedEnvironments) > 0 {
 		f.Environments = make(map[string]EnvironmentModel, len(fetchedFeature.FetchedEnvironments))
 		for _, fetchedEnv := range fetchedFeature.FetchedEnvironments {
@@ -282,6 +376,28 @@ func toFeatureModel(fetchedFeature unleash.FetchedFeature) (FeatureModel, error)
 			f.Environments[fetchedEnv.Environment.Name] = environmentModel
 		}
 	}
+	if fetchedFeature.Feature.Dependencies != nil {
+		for _, dependency := range *fetchedFeature.Feature.Dependencies {
+			dependencyModel := FeatureDependencyModel{
+				Feature: types.StringValue(dependency.Feature),
+				Enabled: types.BoolValue(dependency.Enabled == nil || *dependency.Enabled),
+			}
+			if dependency.Variants != nil && len(*dependency.Variants) > 0 {
+				for _, variant := range *dependency.Variants {
+					dependencyModel.Variants = append(dependencyModel.Variants, types.StringValue(variant))
+				}
+			}
+			f.Dependencies = append(f.Dependencies, dependencyModel)
+		}
+	}
+	if fetchedFeature.Feature.Tags != nil {
+		for _, tag := range *fetchedFeature.Feature.Tags {
+			f.Tags = append(f.Tags, FeatureTagModel{
+				Type:  types.StringValue(tag.Type),
+				Value: types.StringValue(tag.Value),
+			})
+		}
+	}
 
 	return f, nil
 }
@@ -308,6 +424,16 @@ func toEnvironmentModel(fetchedEnv unleash.FetchedEnvironment) (EnvironmentModel
 	return envModel, nil
 }
 
+// isManagingEnabled returns false if whether the environment is enabled is left to other resources.
+func isManagingEnabled(environment EnvironmentModel) bool {
+	return environment.ManageEnabled.IsNull() || environment.ManageEnabled.IsUnknown() || environment.ManageEnabled.ValueBool()
+}
+
+// isManagingStrategies returns false if the strategies of the environment are left to other resources.
+func isManagingStrategies(environment EnvironmentModel) bool {
+	return environment.ManageStrategies.IsNull() || environment.ManageStrategies.IsUnknown() || environment.ManageStrategies.ValueBool()
+}
+
 func toVariantModel(variant unleash.VariantSchema) (VariantModel, error) {
 	variantModel := VariantModel{
 		Name: types.StringValue(variant.Name),
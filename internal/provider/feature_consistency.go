@@ -14,6 +14,14 @@ func ensureFeatureModelNullAndEmptyConsistency(featureModel *FeatureModel, featu
 	tryUpdateToFalseIfBeforeFalse(featureModel.ImpressionData, featureModelBefore.ImpressionData, func(value types.Bool) {
 		featureModel.ImpressionData = value
 	})
+	ensureFeatureDependenciesNullAndEmptyConsistency(featureModel, featureModelBefore)
+	if featureModelBefore.Tags == nil && !isImportedFeature(featureModelBefore) {
+		// tags are not managed if they are not specified
+		featureModel.Tags = nil
+	} else if isNullArrayAndExistingEmptyArray(featureModel.Tags, featureModelBefore.Tags) {
+		featureModel.Tags = []FeatureTagModel{}
+	}
+	ensureEnvironmentsManagementConsistency(featureModel, featureModelBefore)
 	if len(featureModel.Environments) != len(featureModelBefore.Environments) {
 		return
 	}
@@ -27,6 +35,62 @@ func ensureFeatureModelNullAndEmptyConsistency(featureModel *FeatureModel, featu
 	}
 }
 
+func ensureFeatureDependenciesNullAndEmptyConsistency(featureModel *FeatureModel, featureModelBefore FeatureModel) {
+	if featureModelBefore.Dependencies == nil && !isImportedFeature(featureModelBefore) {
+		// dependencies are not managed if they are not specified
+		featureModel.Dependencies = nil
+		return
+	}
+	if isNullArrayAndExistingEmptyArray(featureModel.Dependencies, featureModelBefore.Dependencies) {
+		featureModel.Dependencies = []FeatureDependencyModel{}
+		return
+	}
+	dependencyBeforeByFeature := make(map[string]FeatureDependencyModel, len(featureModelBefore.Dependencies))
+	for _, dependencyBefore := range featureModelBefore.Dependencies {
+		dependencyBeforeByFeature[dependencyBefore.Feature.ValueString()] = dependencyBefore
+	}
+	for i := range featureModel.Dependencies {
+		dependency := &featureModel.Dependencies[i]
+		dependencyBefore, ok := dependencyBeforeByFeature[dependency.Feature.ValueString()]
+		if !ok {
+			continue
+		}
+		if dependencyBefore.Enabled.IsNull() && dependency.Enabled.ValueBool() {
+			// enabled is true by default
+			dependency.Enabled = dependencyBefore.Enabled
+		}
+		if isNullArrayAndExistingEmptyArray(dependency.Variants, dependencyBefore.Variants) {
+			dependency.Variants = []types.String{}
+		}
+	}
+}
+
+// isImportedFeature returns true if the feature is being imported, so that nothing but its ID is known
+// and all of its attributes are read from Unleash.
+func isImportedFeature(featureModelBefore FeatureModel) bool {
+	return featureModelBefore.Name.IsNull()
+}
+
+// ensureEnvironmentsManagementConsistency keeps manage_enabled and manage_strategies, which Unleash does not know,
+// and drops enabled and strategies of environments where they are managed by other resources.
+func ensureEnvironmentsManagementConsistency(featureModel *FeatureModel, featureModelBefore FeatureModel) {
+	for name, env := range featureModel.Environments {
+		envBefore, ok := featureModelBefore.Environments[name]
+		if !ok {
+			continue
+		}
+		env.ManageEnabled = envBefore.ManageEnabled
+		if !isManagingEnabled(env) {
+			env.Enabled = types.BoolNull()
+		}
+		env.ManageStrategies = envBefore.ManageStrategies
+		if !isManagingStrategies(env) {
+			env.Strategies = nil
+		}
+		featureModel.Environments[name] = env
+	}
+}
+
 func ensureEnvironmentNullAndEmptyConsistency(env *EnvironmentModel, envBefore EnvironmentModel) {
 	if isNullArrayAndExistingEmptyArray(env.Variants, envBefore.Variants) {
 		env.Variants = []VariantModel{}
@@ -40,7 +104,7 @@ func ensureEnvironmentNullAndEmptyConsistency(env *EnvironmentModel, envBefore E
 			ensureVariantNullAndEmptyConsistency(variant, variantBefore)
 		}
 	}
-	if len(env.Strategies) == len(envBefore.Strategies) {
+	if isManagingStrategies(*env) && len(env.Strategies) == len(envBefore.Strategies) {
 		allMatched := true
 		strategyByName := toStrategyModelByIDName(env.Strategies)
 		strategies := make([]StrategyModel, len(env.Strategies))
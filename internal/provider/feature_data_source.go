@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+var _ datasource.DataSource = &FeatureDataSource{}
+
+func NewFeatureDataSource() datasource.DataSource {
+	return &FeatureDataSource{}
+}
+
+type FeatureDataSource struct {
+	providerData UnleashProviderData
+}
+
+type FeatureDataSourceModel struct {
+	ID             types.String                                 `tfsdk:"id"`
+	Project        types.String                                 `tfsdk:"project"`
+	Name           types.String                                 `tfsdk:"name"`
+	Exists         types.Bool                                   `tfsdk:"exists"`
+	Type           types.String                                 `tfsdk:"type"`
+	Description    types.String                                 `tfsdk:"description"`
+	ImpressionData types.Bool                                   `tfsdk:"impression_data"`
+	Stale          types.Bool                                   `tfsdk:"stale"`
+	Environments   map[string]FeatureEnvironmentDataSourceModel `tfsdk:"environments"`
+	Tags           []FeatureTagModel                            `tfsdk:"tags"`
+}
+
+type FeatureEnvironmentDataSourceModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+func (d *FeatureDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature"
+}
+
+func (d *FeatureDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Feature data source. A feature which does not exist or is archived is not an error, " +
+			"`exists` is false and the other attributes are null instead.",
+
+		Attributes: createFeatureDataSourceSchemaAttr(true),
+	}
+}
+
+// createFeatureDataSourceSchemaAttr creates the attributes of a feature data source.
+// The project and the name are only required when the feature is looked up by them.
+func createFeatureDataSourceSchemaAttr(lookup bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "ID which is a combination of project , `.` and feature name. e.g. default.my-feature",
+			Computed:    true,
+		},
+		"project": schema.StringAttribute{
+			Description: "The name of project this feature belongs to",
+			Required:    lookup,
+			Computed:    !lookup,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of this feature",
+			Required:    lookup,
+			Computed:    !lookup,
+		},
+		"exists": schema.BoolAttribute{
+			Description: "true if the feature exists and is not archived, otherwise false",
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "Type of the toggle e.g. experiment, kill-switch, release, operational, permission",
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "Detailed description of the feature",
+			Computed:    true,
+		},
+		"impression_data": schema.BoolAttribute{
+			Description: "true if the impression data collection is enabled for the feature, otherwise false",
+			Computed:    true,
+		},
+		"stale": schema.BoolAttribute{
+			Description: "true if the feature is marked as stale, otherwise false",
+			Computed:    true,
+		},
+		"environments": schema.MapNestedAttribute{
+			Description: "The environments of the feature by name",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Description: "true if the feature is enabled in the environment, otherwise false",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"tags": schema.SetNestedAttribute{
+			Description: "Tags of the feature",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "The name of the tag type",
+						Computed:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "The value of the tag",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FeatureDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *FeatureDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FeatureDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading feature", map[string]interface{}{"projectID": data.Project.ValueString(), "featureName": data.Name.ValueString()})
+	featureResp, err := d.providerData.Client.GetFeatureWithResponse(ctx, data.Project.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get feature", err.Error())
+		return
+	}
+	switch {
+	case featureResp.StatusCode() == 404 || (featureResp.JSON200 != nil && featureResp.JSON200.Archived != nil && *featureResp.JSON200.Archived):
+		data.Exists = types.BoolValue(false)
+	case featureResp.StatusCode() > 299:
+		resp.Diagnostics.AddError("failed to get feature", fmt.Sprintf(" with status %d %s", featureResp.StatusCode(), string(featureResp.Body)))
+		return
+	default:
+		data = toFeatureDataSourceModel(data.Project.ValueString(), *featureResp.JSON200)
+	}
+
+	tflog.Trace(ctx, "read data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// toFeatureDataSourceModel converts a feature as it is listed, so strategies and variants are not needed.
+func toFeatureDataSourceModel(projectID string, feature unleash.FeatureSchema) FeatureDataSourceModel {
+	dataSourceModel := FeatureDataSourceModel{
+		ID:             types.StringValue(projectID + "." + feature.Name),
+		Project:        types.StringValue(projectID),
+		Name:           types.StringValue(feature.Name),
+		Exists:         types.BoolValue(true),
+		Type:           types.StringPointerValue(feature.Type),
+		ImpressionData: types.BoolPointerValue(feature.ImpressionData),
+		Stale:          types.BoolValue(feature.Stale != nil && *feature.Stale),
+		Environments:   make(map[string]FeatureEnvironmentDataSourceModel),
+	}
+	if feature.Description != nil && *feature.Description != "" {
+		dataSourceModel.Description = types.StringValue(*feature.Description)
+	}
+	if feature.Environments != nil {
+		for _, environment := range *feature.Environments {
+			dataSourceModel.Environments[environment.Name] = FeatureEnvironmentDataSourceModel{
+				Enabled: types.BoolValue(environment.Enabled),
+			}
+		}
+	}
+	if feature.Tags != nil {
+		for _, tag := range *feature.Tags {
+			dataSourceModel.Tags = append(dataSourceModel.Tags, FeatureTagModel{
+				Type:  types.StringValue(tag.Type),
+				Value: types.StringValue(tag.Value),
+			})
+		}
+	}
+
+	return dataSourceModel
+}
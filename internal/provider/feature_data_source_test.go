@@ -0,0 +1,96 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestAccFeatureDataSource(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConf + testFeatureDataSourceFeaturesConf + `
+data "unleash_feature" "payment" {
+	project = "default"
+	name = unleash_feature.payment.name
+}
+
+data "unleash_feature" "missing" {
+	project = "default"
+	name = "test-feature.missing"
+}
+
+data "unleash_features" "all" {
+	project = "default"
+	depends_on = [unleash_feature.payment, unleash_feature.checkout]
+}
+
+data "unleash_features" "experiment" {
+	project = "default"
+	type = "experiment"
+	depends_on = [unleash_feature.payment, unleash_feature.checkout]
+}
+
+data "unleash_features" "tagged" {
+	project = "default"
+	tag = {
+		type = "simple"
+		value = "payments"
+	}
+	depends_on = [unleash_feature.payment, unleash_feature.checkout]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unleash_feature.payment", "id", "default.test-feature.payment"),
+					resource.TestCheckResourceAttr("data.unleash_feature.payment", "exists", "true"),
+					resource.TestCheckResourceAttr("data.unleash_feature.payment", "type", "release"),
+					resource.TestCheckResourceAttr("data.unleash_feature.payment", "description", "Payment feature"),
+					resource.TestCheckResourceAttr("data.unleash_feature.payment", "stale", "false"),
+					resource.TestCheckResourceAttr("data.unleash_feature.payment", "environments.development.enabled", "true"),
+					resource.TestCheckResourceAttr("data.unleash_feature.payment", "environments.production.enabled", "false"),
+					resource.TestCheckResourceAttr("data.unleash_feature.payment", "tags.#", "1"),
+					resource.TestCheckResourceAttr("data.unleash_feature.missing", "exists", "false"),
+					resource.TestCheckNoResourceAttr("data.unleash_feature.missing", "type"),
+					resource.TestCheckNoResourceAttr("data.unleash_feature.missing", "environments"),
+					resource.TestCheckResourceAttr("data.unleash_features.all", "features.#", "2"),
+					resource.TestCheckResourceAttr("data.unleash_features.all", "features.0.exists", "true"),
+					resource.TestCheckResourceAttr("data.unleash_features.all", "features.0.name", "test-feature.checkout"),
+					resource.TestCheckResourceAttr("data.unleash_features.all", "features.1.name", "test-feature.payment"),
+					resource.TestCheckResourceAttr("data.unleash_features.experiment", "features.#", "1"),
+					resource.TestCheckResourceAttr("data.unleash_features.experiment", "features.0.name", "test-feature.checkout"),
+					resource.TestCheckResourceAttr("data.unleash_features.tagged", "features.#", "1"),
+					resource.TestCheckResourceAttr("data.unleash_features.tagged", "features.0.name", "test-feature.payment"),
+				),
+			},
+		},
+	})
+}
+
+const testFeatureDataSourceFeaturesConf = `
+resource "unleash_feature" "payment" {
+	project = "default"
+	name = "test-feature.payment"
+	type = "release"
+	description = "Payment feature"
+	environments = ` + testFeatureDependencyEnvironments + `
+	tags = [
+		{
+			type = "simple"
+			value = "payments"
+		}
+	]
+}
+
+resource "unleash_feature" "checkout" {
+	project = "default"
+	name = "test-feature.checkout"
+	type = "experiment"
+	environments = ` + testFeatureDependencyEnvironments + `
+}
+`
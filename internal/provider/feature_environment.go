@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type FeatureEnvironmentModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Project     types.String   `tfsdk:"project"`
+	Feature     types.String   `tfsdk:"feature"`
+	Environment types.String   `tfsdk:"environment"`
+	Enabled     types.Bool     `tfsdk:"enabled"`
+	Variants    []VariantModel `tfsdk:"variants"`
+}
+
+func createFeatureEnvironmentResourceSchemaAttr() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "ID which is a combination of project, feature and environment separated by `/`. e.g. default/my-feature/production",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"project": schema.StringAttribute{
+			Description: "The name of project the feature belongs to",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"feature": schema.StringAttribute{
+			Description: "The name of the feature",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"environment": schema.StringAttribute{
+			Description: "The name of the environment. manage_enabled of the environment in unleash_feature must be false",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			Description: "Is this environment enabled",
+			Required:    true,
+		},
+		"variants": schema.ListNestedAttribute{
+			Description: "Variants of this feature in the environment. The variants are left as they are in Unleash if this is not specified. " +
+				"Do not specify them if the feature is managed by unleash_feature, which always manages variants",
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: createVariantResourceSchemaAttrs(),
+			},
+		},
+	}
+}
+
+func toFeatureEnvironmentModel(projectID string, featureName string, environmentID string, environmentModel EnvironmentModel) FeatureEnvironmentModel {
+	return FeatureEnvironmentModel{
+		ID:          types.StringValue(projectID + "/" + featureName + "/" + environmentID),
+		Project:     types.StringValue(projectID),
+		Feature:     types.StringValue(featureName),
+		Environment: types.StringValue(environmentID),
+		Enabled:     environmentModel.Enabled,
+		Variants:    environmentModel.Variants,
+	}
+}
+
+func toEnvironmentModelFromFeatureEnvironment(featureEnvironment FeatureEnvironmentModel) EnvironmentModel {
+	return EnvironmentModel{
+		Enabled:  featureEnvironment.Enabled,
+		Variants: featureEnvironment.Variants,
+		// strategies are never touched through a feature environment
+		ManageStrategies: types.BoolValue(false),
+	}
+}
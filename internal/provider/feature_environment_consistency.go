@@ -0,0 +1,12 @@
+package provider
+
+func ensureFeatureEnvironmentModelNullAndEmptyConsistency(featureEnvironmentModel *FeatureEnvironmentModel, featureEnvironmentModelBefore FeatureEnvironmentModel) {
+	// variants are not managed if they are not specified
+	if featureEnvironmentModelBefore.Variants == nil {
+		featureEnvironmentModel.Variants = nil
+		return
+	}
+	env := toEnvironmentModelFromFeatureEnvironment(*featureEnvironmentModel)
+	ensureEnvironmentNullAndEmptyConsistency(&env, toEnvironmentModelFromFeatureEnvironment(featureEnvironmentModelBefore))
+	featureEnvironmentModel.Variants = env.Variants
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+var _ resource.Resource = &FeatureEnvironmentResource{}
+var _ resource.ResourceWithImportState = &FeatureEnvironmentResource{}
+
+func NewFeatureEnvironmentResource() resource.Resource {
+	return &FeatureEnvironmentResource{}
+}
+
+type FeatureEnvironmentResource struct {
+	providerData UnleashProviderData
+}
+
+type FeatureEnvironmentResourceModel struct {
+	FeatureEnvironmentModel
+}
+
+func (r *FeatureEnvironmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_environment"
+}
+
+func (r *FeatureEnvironmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Feature environment resource which manages whether a feature is enabled in an environment, and optionally its variants. " +
+			"The feature is not created by this resource and destroying it leaves the environment as it is. " +
+			"Set `manage_enabled = false` for the environment in `unleash_feature` so that both resources do not fight over the toggle. " +
+			"A feature environment is imported by `<project>/<feature>/<environment>` without its variants.",
+
+		Attributes: createFeatureEnvironmentResourceSchemaAttr(),
+	}
+}
+
+func (r *FeatureEnvironmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *FeatureEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FeatureEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existingEnv, found, err := getFeatureEnvironment(ctx, r.providerData.Client, data.Project.ValueString(), data.Feature.ValueString(), data.Environment.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get feature environment", err.Error())
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("failed to get feature environment",
+			fmt.Sprintf("feature %s of project %s does not exist or has no environment %s", data.Feature.ValueString(), data.Project.ValueString(), data.Environment.ValueString()))
+		return
+	}
+	err = r.updateFeatureEnvironment(ctx, data.FeatureEnvironmentModel, existingEnv)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update feature environment", err.Error())
+		return
+	}
+	data.ID = types.StringValue(data.Project.ValueString() + "/" + data.Feature.ValueString() + "/" + data.Environment.ValueString())
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// updateFeatureEnvironment updates the variants, when they are managed, and then the status of the environment.
+func (r *FeatureEnvironmentResource) updateFeatureEnvironment(ctx context.Context, featureEnvironment FeatureEnvironmentModel, existingEnv EnvironmentModel) error {
+	projectID := featureEnvironment.Project.ValueString()
+	featureName := featureEnvironment.Feature.ValueString()
+	environmentID := featureEnvironment.Environment.ValueString()
+	environment := toEnvironmentModelFromFeatureEnvironment(featureEnvironment)
+	if environment.Variants != nil {
+		_, err := updateEnvironmentVariants(ctx, r.providerData.Client, projectID, featureName, environmentID, environment, existingEnv)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := updateEnvironmentStatus(ctx, r.providerData.Client, projectID, featureName, environmentID, environment, existingEnv)
+
+	return err
+}
+
+// getFeatureEnvironment returns false if the feature does not exist, is archived or does not have the environment.
+func getFeatureEnvironment(ctx context.Context, client unleash.ClientWithResponsesInterface, projectID string, featureName string, environmentID string) (EnvironmentModel, bool, error) {
+	tflog.Debug(ctx, "Reading feature environment", map[string]interface{}{
+		"projectID":     projectID,
+		"featureName":   featureName,
+		"environmentID": environmentID,
+	})
+	fetchedFeature, found, err := unleash.GetFeature(ctx, client, projectID, featureName)
+	if err != nil {
+		return EnvironmentModel{}, false, err
+	}
+	if !found || (fetchedFeature.Feature.Archived != nil && *fetchedFeature.Feature.Archived) {
+		return EnvironmentModel{}, false, nil
+	}
+	for _, fetchedEnv := range fetchedFeature.FetchedEnvironments {
+		if fetchedEnv.Environment.Name != environmentID {
+			continue
+		}
+		environmentModel, err := toEnvironmentModel(fetchedEnv)
+		if err != nil {
+			return EnvironmentModel{}, false, err
+		}
+
+		return environmentModel, true, nil
+	}
+
+	return EnvironmentModel{}, false, nil
+}
+
+func (r *FeatureEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FeatureEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentModel, found, err := getFeatureEnvironment(ctx, r.providerData.Client, data.Project.ValueString(), data.Feature.ValueString(), data.Environment.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get feature environment", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	featureEnvironmentModel := toFeatureEnvironmentModel(data.Project.ValueString(), data.Feature.ValueString(), data.Environment.ValueString(), environmentModel)
+	ensureFeatureEnvironmentModelNullAndEmptyConsistency(&featureEnvironmentModel, data.FeatureEnvironmentModel)
+	data.FeatureEnvironmentModel = featureEnvironmentModel
+
+	tflog.Trace(ctx, "read resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FeatureEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FeatureEnvironmentResourceModel
+	var existingData FeatureEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &existingData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateFeatureEnvironment(ctx, data.FeatureEnvironmentModel, toEnvironmentModelFromFeatureEnvironment(existingData.FeatureEnvironmentModel))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update feature environment "+data.ID.String(), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the resource from the state. The feature belongs to unleash_feature or the UI so its environment is left as it is.
+func (r *FeatureEnvironmentResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState accepts `<project>/<feature>/<environment>`. Feature and environment names may contain dots but not slashes.
+func (r *FeatureEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || slices.Contains(parts, "") {
+		resp.Diagnostics.AddError("Invalid Feature Environment Import ID",
+			fmt.Sprintf("Expected <project>/<feature>/<environment> but got %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("feature"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment"), parts[2])...)
+}
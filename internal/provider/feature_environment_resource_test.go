@@ -0,0 +1,82 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestAccFeatureEnvironmentResource(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+	featureConf := `
+resource "unleash_feature" "shared" {
+	project = "default"
+	name = "test-feature.shared"
+	type = "release"
+	environments = {
+		development = {
+			enabled = true
+			strategies = []
+		}
+		production = {
+			manage_enabled = false
+			strategies = [
+				{
+					name = "default"
+					disabled = false
+				},
+			]
+		}
+	}
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConf + featureConf + `
+resource "unleash_feature_environment" "production" {
+	project = unleash_feature.shared.project
+	feature = unleash_feature.shared.name
+	environment = "production"
+	enabled = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_environment.production", "id", "default/test-feature.shared/production"),
+					resource.TestCheckResourceAttr("unleash_feature_environment.production", "enabled", "true"),
+					resource.TestCheckNoResourceAttr("unleash_feature_environment.production", "variants"),
+					// the feature does not manage whether production is enabled
+					resource.TestCheckResourceAttr("unleash_feature.shared", "environments.production.manage_enabled", "false"),
+					resource.TestCheckNoResourceAttr("unleash_feature.shared", "environments.production.enabled"),
+					resource.TestCheckResourceAttr("unleash_feature.shared", "environments.production.strategies.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unleash_feature_environment.production",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			//	Update and Read testing
+			{
+				Config: providerConf + featureConf + `
+resource "unleash_feature_environment" "production" {
+	project = unleash_feature.shared.project
+	feature = unleash_feature.shared.name
+	environment = "production"
+	enabled = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_environment.production", "enabled", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
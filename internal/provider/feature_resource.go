@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,6 +23,7 @@ import (
 
 var _ resource.Resource = &FeatureResource{}
 var _ resource.ResourceWithImportState = &FeatureResource{}
+var _ resource.ResourceWithModifyPlan = &FeatureResource{}
 
 func NewFeatureResource() resource.Resource {
 	return &FeatureResource{}
@@ -67,6 +72,181 @@ func (r *FeatureResource) Configure(_ context.Context, req resource.ConfigureReq
 	r.providerData = providerData
 }
 
+func (r *FeatureResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to validate when the feature is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var projectID types.String
+	var environments types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project"), &projectID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("environments"), &environments)...)
+	if resp.Diagnostics.HasError() || projectID.IsUnknown() || environments.IsUnknown() {
+		return
+	}
+
+	validateEnvironmentsManagement(environments, &resp.Diagnostics)
+	// segments can only be validated once the provider is configured
+	if r.providerData.Client == nil {
+		return
+	}
+	var priorReferences []segmentReference
+	if !req.State.Raw.IsNull() {
+		var priorProjectID types.String
+		var priorEnvironments types.Map
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("project"), &priorProjectID)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("environments"), &priorEnvironments)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if priorProjectID.Equal(projectID) {
+			priorReferences = collectEnvironmentSegmentReferences(priorEnvironments)
+		}
+	}
+	validateSegmentProjects(ctx, r.providerData.Client, projectID.ValueString(), collectEnvironmentSegmentReferences(environments), priorReferences, &resp.Diagnostics)
+}
+
+// validateEnvironmentsManagement ensures enabled and strategies are specified if and only if the environment manages them.
+func validateEnvironmentsManagement(environments types.Map, diags *diag.Diagnostics) {
+	for environmentName, environment := range environments.Elements() {
+		environmentObject, ok := environment.(types.Object)
+		if !ok || environmentObject.IsNull() || environmentObject.IsUnknown() {
+			continue
+		}
+		validateManagedAttribute(environmentName, environmentObject.Attributes(), "manage_enabled", "enabled", diags)
+		validateManagedAttribute(environmentName, environmentObject.Attributes(), "manage_strategies", "strategies", diags)
+	}
+}
+
+func validateManagedAttribute(environmentName string, attributes map[string]attr.Value, manageAttrName string, attrName string, diags *diag.Diagnostics) {
+	manage, ok := attributes[manageAttrName].(types.Bool)
+	if !ok || manage.IsUnknown() {
+		return
+	}
+	value, ok := attributes[attrName]
+	if !ok || value.IsUnknown() {
+		return
+	}
+	attrPath := path.Root("environments").AtMapKey(environmentName).AtName(attrName)
+	managed := manage.IsNull() || manage.ValueBool()
+	if managed && value.IsNull() {
+		diags.AddAttributeError(attrPath, "Missing Attribute",
+			fmt.Sprintf("%s of environment %s must be specified unless %s is false.", attrName, environmentName, manageAttrName))
+	}
+	if !managed && !value.IsNull() {
+		diags.AddAttributeError(attrPath, "Unmanaged Attribute",
+			fmt.Sprintf("%s of environment %s must not be specified since %s is false.", attrName, environmentName, manageAttrName))
+	}
+}
+
+// segmentReference is a segment used by a strategy.
+type segmentReference struct {
+	id   string
+	path path.Path
+}
+
+// collectEnvironmentSegmentReferences returns the known segments used by the strategies of the environments.
+func collectEnvironmentSegmentReferences(environments types.Map) []segmentReference {
+	var references []segmentReference
+	for environmentName, environment := range environments.Elements() {
+		environmentObject, ok := environment.(types.Object)
+		if !ok || environmentObject.IsNull() || environmentObject.IsUnknown() {
+			continue
+		}
+		strategies, ok := environmentObject.Attributes()["strategies"].(types.List)
+		if !ok || strategies.IsNull() || strategies.IsUnknown() {
+			continue
+		}
+		for i, strategy := range strategies.Elements() {
+			strategyObject, ok := strategy.(types.Object)
+			if !ok || strategyObject.IsNull() || strategyObject.IsUnknown() {
+				continue
+			}
+			segments, ok := strategyObject.Attributes()["segments"].(types.Set)
+			if !ok {
+				continue
+			}
+			segmentsPath := path.Root("environments").AtMapKey(environmentName).AtName("strategies").AtListIndex(i).AtName("segments")
+			references = append(references, collectSegmentReferences(segments, segmentsPath)...)
+		}
+	}
+
+	return references
+}
+
+// collectSegmentReferences returns the known segments of a strategy.
+func collectSegmentReferences(segments types.Set, segmentsPath path.Path) []segmentReference {
+	if segments.IsNull() || segments.IsUnknown() {
+		return nil
+	}
+	var references []segmentReference
+	for _, segment := range segments.Elements() {
+		segmentID, ok := segment.(types.Float32)
+		// segments which are created in the same plan are unknown
+		if !ok || segmentID.IsNull() || segmentID.IsUnknown() {
+			continue
+		}
+		references = append(references, segmentReference{
+			id:   fmt.Sprintf("%d", int64(segmentID.ValueFloat32())),
+			path: segmentsPath,
+		})
+	}
+
+	return references
+}
+
+// validateSegmentProjects ensures segments of strategies are global or belong to the project of the feature
+// since Unleash only rejects them when the strategies are updated. Segments which are already used in the prior state
+// of the same project are not validated again, so the segments are only fetched, at once, when others are used.
+func validateSegmentProjects(ctx context.Context, client unleash.ClientWithResponsesInterface, projectID string, references []segmentReference, priorReferences []segmentReference, diags *diag.Diagnostics) {
+	priorIDs := make(map[string]bool, len(priorReferences))
+	for _, reference := range priorReferences {
+		priorIDs[reference.id] = true
+	}
+	references = slices.DeleteFunc(slices.Clone(references), func(reference segmentReference) bool {
+		return priorIDs[reference.id]
+	})
+	if len(references) == 0 {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading segments to validate their projects", map[string]interface{}{"projectID": projectID})
+	segmentsResp, err := client.GetSegmentsWithResponse(ctx)
+	if err != nil {
+		diags.AddError("Failed to Read Segments", err.Error())
+		return
+	}
+	if segmentsResp.StatusCode() > 299 {
+		diags.AddError("Failed to Read Segments", fmt.Sprintf("failed to get segments with status %d %s", segmentsResp.StatusCode(), string(segmentsResp.Body)))
+		return
+	}
+	segmentProjects := make(map[string]string)
+	if segmentsResp.JSON200.Segments != nil {
+		for _, segment := range *segmentsResp.JSON200.Segments {
+			// an empty project is a global segment
+			segmentProject := ""
+			if segment.Project != nil {
+				segmentProject = *segment.Project
+			}
+			segmentProjects[fmt.Sprintf("%d", segment.Id)] = segmentProject
+		}
+	}
+
+	for _, reference := range references {
+		segmentProject, ok := segmentProjects[reference.id]
+		if !ok {
+			diags.AddAttributeError(reference.path, "Failed to Read Segment", fmt.Sprintf("segment %s does not exist", reference.id))
+			continue
+		}
+		if segmentProject != "" && segmentProject != projectID {
+			diags.AddAttributeError(reference.path, "Segment Belongs to Another Project",
+				fmt.Sprintf("Segment %s belongs to project %q but the feature is in project %q. "+
+					"Only global segments and segments of the same project can be used by strategies.", reference.id, segmentProject, projectID))
+		}
+	}
+}
+
 func (r *FeatureResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data FeatureResourceModel
 
@@ -92,22 +272,103 @@ func (r *FeatureResource) Create(ctx context.Context, req resource.CreateRequest
 		resp.Diagnostics.AddError("failed to create feature "+data.ID.String(), err.Error())
 		return
 	}
-	if createResp.StatusCode() > 299 {
+	existingFeatureModel := FeatureModel{
+		Stale: types.BoolValue(false),
+	}
+	if createResp.StatusCode() == 409 {
+		var revived bool
+		existingFeatureModel, revived, err = r.reviveFeature(ctx, data.Project.ValueString(), data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("failed to revive feature "+data.ID.String(), err.Error())
+			return
+		}
+		if !revived {
+			resp.Diagnostics.AddError("failed to create feature "+data.ID.String(), fmt.Sprintf(" with status %d %s", createResp.StatusCode(), string(createResp.Body)))
+			return
+		}
+		err = r.updateFeature(ctx, data, FeatureResourceModel{existingFeatureModel})
+		if err != nil {
+			resp.Diagnostics.AddError("failed to update revived feature "+data.ID.String(), err.Error())
+			return
+		}
+	} else if createResp.StatusCode() > 299 {
 		resp.Diagnostics.AddError("failed to create feature "+data.ID.String(), fmt.Sprintf(" with status %d %s", createResp.StatusCode(), string(createResp.Body)))
 		return
 	}
 
-	err = r.updateEnvironments(ctx, data.Project.ValueString(), data.Name.ValueString(), data.Environments, map[string]EnvironmentModel{})
+	if existingFeatureModel.Environments == nil {
+		existingFeatureModel.Environments = map[string]EnvironmentModel{}
+	}
+	err = r.updateEnvironments(ctx, data.Project.ValueString(), data.Name.ValueString(), data.Environments, existingFeatureModel.Environments)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create environments", err.Error())
 		return
 	}
+	err = r.updateDependencies(ctx, data.Project.ValueString(), data.Name.ValueString(), data.Dependencies, existingFeatureModel.Dependencies)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create dependencies", err.Error())
+		return
+	}
+	err = r.updateTags(ctx, data.Name.ValueString(), data.Tags, existingFeatureModel.Tags)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create tags", err.Error())
+		return
+	}
+	data.Stale, err = r.updateStale(ctx, data.Project.ValueString(), data.Name.ValueString(), data.Stale, existingFeatureModel.Stale)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to mark feature as stale", err.Error())
+		return
+	}
 
 	tflog.Trace(ctx, "created a resource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// reviveFeature revives an archived feature which has the same name as the feature being created.
+// It returns false if there is no such archived feature in the project and the revived feature otherwise so that its existing properties can be reconciled.
+// The project is checked before reviving because features are revived by name only.
+func (r *FeatureResource) reviveFeature(ctx context.Context, projectID string, featureName string) (FeatureModel, bool, error) {
+	tflog.Debug(ctx, "Reading archived features", map[string]interface{}{"projectID": projectID})
+	archivedResp, err := r.providerData.Client.GetArchivedFeaturesByProjectIdWithResponse(ctx, projectID)
+	if err != nil {
+		return FeatureModel{}, false, err
+	}
+	if archivedResp.StatusCode() > 299 {
+		return FeatureModel{}, false, fmt.Errorf("failed to read archived features of project %s with status %d %s", projectID, archivedResp.StatusCode(), string(archivedResp.Body))
+	}
+	if !slices.ContainsFunc(archivedResp.JSON200.Features, func(feature unleash.FeatureSchema) bool {
+		return feature.Name == featureName
+	}) {
+		return FeatureModel{}, false, nil
+	}
+
+	tflog.Debug(ctx, "Reviving feature", map[string]interface{}{"projectID": projectID, "featureName": featureName})
+	reviveResp, err := r.providerData.Client.ReviveFeatureWithResponse(ctx, featureName)
+	if err != nil {
+		return FeatureModel{}, false, err
+	}
+	if reviveResp.StatusCode() > 299 {
+		return FeatureModel{}, false, fmt.Errorf("failed to revive feature %s with status %d %s", featureName, reviveResp.StatusCode(), string(reviveResp.Body))
+	}
+
+	fetchedFeature, found, err := unleash.GetFeature(ctx, r.providerData.Client, projectID, featureName)
+	if err != nil {
+		return FeatureModel{}, false, err
+	}
+	if !found {
+		return FeatureModel{}, false, fmt.Errorf("the revived feature %s does not belong to project %s", featureName, projectID)
+	}
+	removeIgnoredStrategies(ctx, &fetchedFeature, r.providerData.StrategyTitleIgnoreRegEx)
+
+	featureModel, err := toFeatureModel(fetchedFeature)
+	if err != nil {
+		return FeatureModel{}, false, err
+	}
+
+	return featureModel, true, nil
+}
+
 func (r *FeatureResource) updateEnvironments(ctx context.Context, projectID string, featureName string, environments map[string]EnvironmentModel, existingEnvironmentByName map[string]EnvironmentModel) error {
 	for name, env := range environments {
 		existingEnv, ok := existingEnvironmentByName[name]
@@ -128,17 +389,24 @@ func (r *FeatureResource) updateEnvironments(ctx context.Context, projectID stri
 }
 
 func (r *FeatureResource) updateEnvironment(ctx context.Context, projectID string, featureName string, environmentID string, environment EnvironmentModel, existingEnv EnvironmentModel) (EnvironmentModel, error) {
-	environment, err := r.updateStrategies(ctx, projectID, featureName, environmentID, environment, existingEnv)
-	if err != nil {
-		return environment, err
+	var err error
+	if isManagingStrategies(environment) {
+		environment, err = r.updateStrategies(ctx, projectID, featureName, environmentID, environment, existingEnv)
+		if err != nil {
+			return environment, err
+		}
 	}
 
-	environment, err = r.updateEnvironmentVariants(ctx, projectID, featureName, environmentID, environment, existingEnv)
+	environment, err = updateEnvironmentVariants(ctx, r.providerData.Client, projectID, featureName, environmentID, environment, existingEnv)
 	if err != nil {
 		return environment, err
 	}
 
-	return r.updateEnvironmentStatus(ctx, projectID, featureName, environmentID, environment, existingEnv)
+	if isManagingEnabled(environment) {
+		return updateEnvironmentStatus(ctx, r.providerData.Client, projectID, featureName, environmentID, environment, existingEnv)
+	}
+
+	return environment, nil
 }
 
 func (r *FeatureResource) updateStrategies(ctx context.Context, projectID string, featureName string, environmentID string, environment EnvironmentModel, existingEnv EnvironmentModel) (EnvironmentModel, error) {
@@ -150,7 +418,7 @@ func (r *FeatureResource) updateStrategies(ctx context.Context, projectID string
 		if ok {
 			continue
 		}
-		err := r.deleteStrategy(ctx, projectID, featureName, environmentID, strategy)
+		err := deleteStrategy(ctx, r.providerData.Client, projectID, featureName, environmentID, strategy)
 		if err != nil {
 			return environment, err
 		}
@@ -162,12 +430,12 @@ func (r *FeatureResource) updateStrategies(ctx context.Context, projectID string
 		key := toStrategyModelKey(strategy)
 		existingStrategy, ok := existingStrategyByKey[key]
 		if ok {
-			err := r.updateStrategy(ctx, projectID, featureName, environmentID, strategy, existingStrategy)
+			err := updateStrategy(ctx, r.providerData.Client, projectID, featureName, environmentID, strategy, existingStrategy)
 			if err != nil {
 				return environment, err
 			}
 		} else {
-			id, err := r.addStrategy(ctx, projectID, featureName, environmentID, strategy)
+			id, err := addStrategy(ctx, r.providerData.Client, projectID, featureName, environmentID, strategy)
 			if err != nil {
 				return environment, err
 			}
@@ -179,7 +447,23 @@ func (r *FeatureResource) updateStrategies(ctx context.Context, projectID string
 	return environment, nil
 }
 
-func (r *FeatureResource) addStrategy(ctx context.Context, projectID string, featureName string, environmentID string, strategy StrategyModel) (string, error) {
+func addStrategy(ctx context.Context, client unleash.ClientWithResponsesInterface, projectID string, featureName string, environmentID string, strategy StrategyModel) (string, error) {
+	strategyBody, err := toAddStrategyBody(strategy)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.AddFeatureStrategyWithResponse(ctx, projectID, featureName, environmentID, strategyBody)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode() > 299 {
+		return "", fmt.Errorf("failed to add strategy for %s %s %s %s with status %d %s", projectID, featureName, environmentID, strategy.Name.ValueString(), resp.StatusCode(), string(resp.Body))
+	}
+
+	return *resp.JSON200.Id, nil
+}
+
+func toAddStrategyBody(strategy StrategyModel) (unleash.AddFeatureStrategyJSONRequestBody, error) {
 	strategyBody := unleash.AddFeatureStrategyJSONRequestBody{
 		Name:      strategy.Name.ValueString(),
 		Title:     strategy.Title.ValueStringPointer(),
@@ -196,7 +480,7 @@ func (r *FeatureResource) addStrategy(ctx context.Context, projectID string, fea
 	if len(strategy.Constraints) > 0 {
 		constraints, err := toConstraintsBody(strategy.Constraints)
 		if err != nil {
-			return "", err
+			return strategyBody, err
 		}
 		strategyBody.Constraints = &constraints
 	}
@@ -232,15 +516,8 @@ func (r *FeatureResource) addStrategy(ctx context.Context, projectID string, fea
 		}
 		strategyBody.Variants = &variants
 	}
-	resp, err := r.providerData.Client.AddFeatureStrategyWithResponse(ctx, projectID, featureName, environmentID, strategyBody)
-	if err != nil {
-		return "", err
-	}
-	if resp.StatusCode() > 299 {
-		return "", fmt.Errorf("failed to add strategy for %s %s %s %s with status %d %s", projectID, featureName, environmentID, strategy.Name.ValueString(), resp.StatusCode(), string(resp.Body))
-	}
 
-	return *resp.JSON200.Id, nil
+	return strategyBody, nil
 }
 
 func toConstraintsBody(constraintModels []ConstraintModel) ([]unleash.ConstraintSchema, error) {
@@ -269,7 +546,7 @@ func toConstraintsBody(constraintModels []ConstraintModel) ([]unleash.Constraint
 	return constraints, nil
 }
 
-func (r *FeatureResource) updateStrategy(ctx context.Context, projectID string, featureName string, environmentID string, strategy StrategyModel, existingStrategy StrategyModel) error {
+func updateStrategy(ctx context.Context, client unleash.ClientWithResponsesInterface, projectID string, featureName string, environmentID string, strategy StrategyModel, existingStrategy StrategyModel) error {
 	body, err := toUpdateStrategyBody(strategy)
 	if err != nil {
 		return err
@@ -284,7 +561,7 @@ func (r *FeatureResource) updateStrategy(ctx context.Context, projectID string,
 			"featureName":   featureName,
 			"environmentID": environmentID,
 			"body":          body})
-		resp, err := r.providerData.Client.UpdateFeatureStrategyWithResponse(ctx, projectID, featureName, environmentID, existingStrategy.Id.ValueString(), body)
+		resp, err := client.UpdateFeatureStrategyWithResponse(ctx, projectID, featureName, environmentID, existingStrategy.Id.ValueString(), body)
 		if err != nil {
 			return err
 		}
@@ -303,7 +580,7 @@ func (r *FeatureResource) updateStrategy(ctx context.Context, projectID string,
 			"environmentID": environmentID,
 			"strategy":      strategy,
 			"order":         order})
-		resp, err := r.providerData.Client.SetStrategySortOrderWithResponse(ctx, projectID, featureName, environmentID, []struct {
+		resp, err := client.SetStrategySortOrderWithResponse(ctx, projectID, featureName, environmentID, []struct {
 			Id        string  `json:"id"`
 			SortOrder float32 `json:"sortOrder"`
 		}{{
@@ -325,7 +602,7 @@ func (r *FeatureResource) updateStrategy(ctx context.Context, projectID string,
 			"environmentID": environmentID,
 			"strategy":      strategy.Name.ValueString(),
 			"body":          updateStrategySegmentBody})
-		resp, err := r.providerData.Client.UpdateFeatureStrategySegmentsWithResponse(ctx, updateStrategySegmentBody)
+		resp, err := client.UpdateFeatureStrategySegmentsWithResponse(ctx, updateStrategySegmentBody)
 		if err != nil {
 			return err
 		}
@@ -418,14 +695,14 @@ func toUpdateStrategySegmentsBody(projectID string, environmentID string, strate
 	return body
 }
 
-func (r *FeatureResource) deleteStrategy(ctx context.Context, projectID string, featureName string, environmentID string, strategy StrategyModel) error {
+func deleteStrategy(ctx context.Context, client unleash.ClientWithResponsesInterface, projectID string, featureName string, environmentID string, strategy StrategyModel) error {
 	tflog.Debug(ctx, "Deleting strategy", map[string]interface{}{
 		"projectID":     projectID,
 		"featureName":   featureName,
 		"environmentID": environmentID,
 		"strategy":      strategy,
 	})
-	resp, err := r.providerData.Client.DeleteFeatureStrategyWithResponse(ctx, projectID, featureName, environmentID, strategy.Id.ValueString())
+	resp, err := client.DeleteFeatureStrategyWithResponse(ctx, projectID, featureName, environmentID, strategy.Id.ValueString())
 	if err != nil {
 		return err
 	}
@@ -452,7 +729,7 @@ func toStrategyModelKey(strategy StrategyModel) string {
 	return key
 }
 
-func (r *FeatureResource) updateEnvironmentVariants(ctx context.Context, projectID string, featureName string, environmentID string, environment EnvironmentModel, existingEnv EnvironmentModel) (EnvironmentModel, error) {
+func updateEnvironmentVariants(ctx context.Context, client unleash.ClientWithResponsesInterface, projectID string, featureName string, environmentID string, environment EnvironmentModel, existingEnv EnvironmentModel) (EnvironmentModel, error) {
 	diff := getVariantDiffMode(environment.Variants, existingEnv.Variants)
 	if diff.Mode == variantDiffModeEqual {
 		return environment, nil
@@ -477,7 +754,7 @@ func (r *FeatureResource) updateEnvironmentVariants(ctx context.Context, project
 		"body":          variantsBody,
 	})
 	// try to do everything in one go first. this however may face a problem when the body is too large...
-	resp, err := r.providerData.Client.OverwriteFeatureVariantsOnEnvironmentsWithResponse(ctx, projectID, featureName, variantsBody)
+	resp, err := client.OverwriteFeatureVariantsOnEnvironmentsWithResponse(ctx, projectID, featureName, variantsBody)
 	if err != nil {
 		return environment, err
 	}
@@ -487,7 +764,7 @@ func (r *FeatureResource) updateEnvironmentVariants(ctx context.Context, project
 			"featureName":   featureName,
 			"environmentID": environmentID,
 		})
-		err := r.handleTooLargeEnvironmentVariants(ctx, projectID, featureName, environmentID, variants, diff)
+		err := handleTooLargeEnvironmentVariants(ctx, client, projectID, featureName, environmentID, variants, diff)
 		return environment, err
 	}
 	if resp.StatusCode() > 299 {
@@ -546,7 +823,7 @@ const variantDiffModeReplaceOnly variantDiffMode = 2
 const variantDiffModeRemoveOnly variantDiffMode = 3
 const variantDiffModeMixed variantDiffMode = 4
 
-func (r *FeatureResource) handleTooLargeEnvironmentVariants(ctx context.Context, projectID string, featureName string, environmentID string, largeVariants []unleash.VariantSchema, diff variantsDiff) error {
+func handleTooLargeEnvironmentVariants(ctx context.Context, client unleash.ClientWithResponsesInterface, projectID string, featureName string, environmentID string, largeVariants []unleash.VariantSchema, diff variantsDiff) error {
 	patches := make([]unleash.PatchSchema, 0, len(largeVariants))
 	switch diff.Mode {
 	case variantDiffModeMixed, variantDiffModeAddOnly:
@@ -591,7 +868,7 @@ func (r *FeatureResource) handleTooLargeEnvironmentVariants(ctx context.Context,
 				smallVariants[i] = smallVariant
 			}
 
-			resp, err := r.providerData.Client.OverwriteFeatureVariantsOnEnvironmentsWithResponse(ctx, projectID, featureName, unleash.OverwriteFeatureVariantsOnEnvironmentsJSONRequestBody{
+			resp, err := client.OverwriteFeatureVariantsOnEnvironmentsWithResponse(ctx, projectID, featureName, unleash.OverwriteFeatureVariantsOnEnvironmentsJSONRequestBody{
 				Environments: &[]string{environmentID},
 				Variants:     &smallVariants,
 			})
@@ -643,7 +920,7 @@ func (r *FeatureResource) handleTooLargeEnvironmentVariants(ctx context.Context,
 			"environmentID": environmentID,
 			"body":          patch,
 		})
-		resp, err := r.providerData.Client.PatchEnvironmentsFeatureVariantsWithResponse(ctx, projectID, featureName, environmentID, unleash.PatchEnvironmentsFeatureVariantsJSONRequestBody{patch})
+		resp, err := client.PatchEnvironmentsFeatureVariantsWithResponse(ctx, projectID, featureName, environmentID, unleash.PatchEnvironmentsFeatureVariantsJSONRequestBody{patch})
 		if err != nil {
 			return err
 		}
@@ -661,7 +938,7 @@ func toPatchValue(body unleash.VariantSchema) *interface{} {
 	return &value
 }
 
-func (r *FeatureResource) updateEnvironmentStatus(ctx context.Context, projectID string, featureName string, environmentID string, environment EnvironmentModel, existingEnv EnvironmentModel) (EnvironmentModel, error) {
+func updateEnvironmentStatus(ctx context.Context, client unleash.ClientWithResponsesInterface, projectID string, featureName string, environmentID string, environment EnvironmentModel, existingEnv EnvironmentModel) (EnvironmentModel, error) {
 	if environment.Enabled == existingEnv.Enabled {
 		return environment, nil
 	}
@@ -672,7 +949,7 @@ func (r *FeatureResource) updateEnvironmentStatus(ctx context.Context, projectID
 			"featureName":   featureName,
 			"environmentID": environmentID,
 		})
-		resp, err := r.providerData.Client.ToggleFeatureEnvironmentOnWithResponse(ctx, projectID, featureName, environmentID)
+		resp, err := client.ToggleFeatureEnvironmentOnWithResponse(ctx, projectID, featureName, environmentID)
 		if err != nil {
 			return environment, err
 		}
@@ -685,7 +962,7 @@ func (r *FeatureResource) updateEnvironmentStatus(ctx context.Context, projectID
 			"featureName":   featureName,
 			"environmentID": environmentID,
 		})
-		resp, err := r.providerData.Client.ToggleFeatureEnvironmentOffWithResponse(ctx, projectID, featureName, environmentID)
+		resp, err := client.ToggleFeatureEnvironmentOffWithResponse(ctx, projectID, featureName, environmentID)
 		if err != nil {
 			return environment, err
 		}
@@ -713,7 +990,7 @@ func (r *FeatureResource) Read(ctx context.Context, req resource.ReadRequest, re
 		resp.Diagnostics.AddError("failed to get feature", err.Error())
 		return
 	}
-	if !found {
+	if !found || (fetchedFeature.Feature.Archived != nil && *fetchedFeature.Feature.Archived) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -750,31 +1027,297 @@ func (r *FeatureResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	var err error
+	existingData.FeatureModel, err = r.withLiveUnmanagedAttributes(ctx, data.FeatureModel, existingData.FeatureModel)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get feature "+data.ID.String(), err.Error())
+		return
+	}
+	err = r.updateFeature(ctx, data, existingData)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update feature "+data.ID.String(), err.Error())
+		return
+	}
+
+	err = r.updateEnvironments(ctx, data.Project.ValueString(), data.Name.ValueString(), data.Environments, existingData.Environments)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update environment", err.Error())
+	}
+	err = r.updateDependencies(ctx, data.Project.ValueString(), data.Name.ValueString(), data.Dependencies, existingData.Dependencies)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update dependencies", err.Error())
+	}
+	err = r.updateTags(ctx, data.Name.ValueString(), data.Tags, existingData.Tags)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update tags", err.Error())
+	}
+	data.Stale, err = r.updateStale(ctx, data.Project.ValueString(), data.Name.ValueString(), data.Stale, existingData.Stale)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to mark feature as stale", err.Error())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// withLiveUnmanagedAttributes replaces the attributes of the existing feature which were not managed, so are not in the state,
+// with the ones in Unleash when they become managed. Otherwise, they would be added again rather than updated.
+func (r *FeatureResource) withLiveUnmanagedAttributes(ctx context.Context, featureModel FeatureModel, existingFeatureModel FeatureModel) (FeatureModel, error) {
+	becomingManagedDependencies := featureModel.Dependencies != nil && existingFeatureModel.Dependencies == nil
+	becomingManagedTags := featureModel.Tags != nil && existingFeatureModel.Tags == nil
+	var becomingManagedStrategies []string
+	for name, env := range featureModel.Environments {
+		existingEnv, ok := existingFeatureModel.Environments[name]
+		if ok && isManagingStrategies(env) && !isManagingStrategies(existingEnv) {
+			becomingManagedStrategies = append(becomingManagedStrategies, name)
+		}
+	}
+	if !becomingManagedDependencies && !becomingManagedTags && len(becomingManagedStrategies) == 0 {
+		return existingFeatureModel, nil
+	}
+
+	projectID := featureModel.Project.ValueString()
+	featureName := featureModel.Name.ValueString()
+	fetchedFeature, found, err := unleash.GetFeature(ctx, r.providerData.Client, projectID, featureName)
+	if err != nil {
+		return existingFeatureModel, err
+	}
+	if !found {
+		return existingFeatureModel, fmt.Errorf("feature %s is not found in project %s", featureName, projectID)
+	}
+	removeIgnoredStrategies(ctx, &fetchedFeature, r.providerData.StrategyTitleIgnoreRegEx)
+	liveFeatureModel, err := toFeatureModel(fetchedFeature)
+	if err != nil {
+		return existingFeatureModel, err
+	}
+	if becomingManagedDependencies {
+		existingFeatureModel.Dependencies = liveFeatureModel.Dependencies
+	}
+	if becomingManagedTags {
+		existingFeatureModel.Tags = liveFeatureModel.Tags
+	}
+	if len(becomingManagedStrategies) > 0 {
+		for _, name := range becomingManagedStrategies {
+			existingEnv := existingFeatureModel.Environments[name]
+			existingEnv.Strategies = liveFeatureModel.Environments[name].Strategies
+			existingFeatureModel.Environments[name] = existingEnv
+		}
+	}
+
+	return existingFeatureModel, nil
+}
+
+func (r *FeatureResource) updateFeature(ctx context.Context, data FeatureResourceModel, existingData FeatureResourceModel) error {
 	featureBody := toFeatureBody(data)
 	existingFeatureBody := toFeatureBody(existingData)
-	if !cmp.Equal(featureBody, existingFeatureBody) {
-		tflog.Debug(ctx, "Updating feature", map[string]interface{}{
-			"projectID":   data.Project.ValueString(),
-			"featureName": data.Name.ValueString(),
-			"body":        featureBody,
+	if cmp.Equal(featureBody, existingFeatureBody) {
+		return nil
+	}
+
+	tflog.Debug(ctx, "Updating feature", map[string]interface{}{
+		"projectID":   data.Project.ValueString(),
+		"featureName": data.Name.ValueString(),
+		"body":        featureBody,
+	})
+	updateResp, err := r.providerData.Client.UpdateFeatureWithResponse(ctx, data.Project.ValueString(), data.Name.ValueString(), featureBody)
+	if err != nil {
+		return err
+	}
+	if updateResp.StatusCode() > 299 {
+		return fmt.Errorf("failed to update feature with status %d %s", updateResp.StatusCode(), string(updateResp.Body))
+	}
+
+	return nil
+}
+
+// updateStale marks the feature as stale or not stale if the stale flag is specified and differs from the existing one.
+// It returns the resolved stale flag.
+func (r *FeatureResource) updateStale(ctx context.Context, projectID string, featureName string, stale types.Bool, existingStale types.Bool) (types.Bool, error) {
+	if stale.IsNull() || stale.IsUnknown() {
+		return existingStale, nil
+	}
+	if stale.Equal(existingStale) {
+		return stale, nil
+	}
+
+	body := unleash.StaleFeaturesJSONRequestBody{
+		Features: []string{featureName},
+		Stale:    stale.ValueBool(),
+	}
+	tflog.Debug(ctx, "Marking feature as stale", map[string]interface{}{
+		"projectID": projectID,
+		"body":      body,
+	})
+	resp, err := r.providerData.Client.StaleFeaturesWithResponse(ctx, projectID, body)
+	if err != nil {
+		return stale, err
+	}
+	if resp.StatusCode() > 299 {
+		return stale, fmt.Errorf("failed to mark %s %s as stale=%t with status %d %s", projectID, featureName, stale.ValueBool(), resp.StatusCode(), string(resp.Body))
+	}
+
+	return stale, nil
+}
+
+// updateDependencies leaves the dependencies as they are if they are null since they are not managed then.
+func (r *FeatureResource) updateDependencies(ctx context.Context, projectID string, featureName string, dependencies []FeatureDependencyModel, existingDependencies []FeatureDependencyModel) error {
+	if dependencies == nil {
+		return nil
+	}
+	if len(dependencies) == 0 && len(existingDependencies) > 0 {
+		tflog.Debug(ctx, "Deleting all dependencies", map[string]interface{}{
+			"projectID":   projectID,
+			"featureName": featureName,
 		})
-		updateResp, err := r.providerData.Client.UpdateFeatureWithResponse(ctx, data.Project.ValueString(), data.Name.ValueString(), featureBody)
+		resp, err := r.providerData.Client.DeleteFeatureDependenciesWithResponse(ctx, projectID, featureName)
 		if err != nil {
-			resp.Diagnostics.AddError("failed to update feature "+data.ID.String(), err.Error())
-			return
+			return err
 		}
-		if updateResp.StatusCode() > 299 {
-			resp.Diagnostics.AddError("failed to update feature "+data.ID.String(), fmt.Sprintf(" with status %d %s", updateResp.StatusCode(), string(updateResp.Body)))
-			return
+		if resp.StatusCode() > 299 {
+			return fmt.Errorf("failed to delete dependencies of %s %s with status %d %s", projectID, featureName, resp.StatusCode(), string(resp.Body))
+		}
+		return nil
+	}
+
+	dependencyBodyByFeature := toDependencyBodyByFeature(dependencies)
+	existingDependencyBodyByFeature := toDependencyBodyByFeature(existingDependencies)
+	for parent := range existingDependencyBodyByFeature {
+		if _, ok := dependencyBodyByFeature[parent]; ok {
+			continue
+		}
+		tflog.Debug(ctx, "Deleting dependency", map[string]interface{}{
+			"projectID":   projectID,
+			"featureName": featureName,
+			"parent":      parent,
+		})
+		resp, err := r.providerData.Client.DeleteFeatureDependencyWithResponse(ctx, projectID, featureName, parent)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode() > 299 && resp.StatusCode() != 404 {
+			return fmt.Errorf("failed to delete dependency %s of %s %s with status %d %s", parent, projectID, featureName, resp.StatusCode(), string(resp.Body))
+		}
+	}
+	for parent, body := range dependencyBodyByFeature {
+		existingBody, ok := existingDependencyBodyByFeature[parent]
+		if ok && cmp.Equal(body, existingBody) {
+			continue
+		}
+		tflog.Debug(ctx, "Adding dependency", map[string]interface{}{
+			"projectID":   projectID,
+			"featureName": featureName,
+			"body":        body,
+		})
+		resp, err := r.providerData.Client.AddFeatureDependencyWithResponse(ctx, projectID, featureName, body)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode() > 299 {
+			return fmt.Errorf("failed to add dependency %s to %s %s with status %d %s", parent, projectID, featureName, resp.StatusCode(), string(resp.Body))
+		}
+	}
+
+	return nil
+}
+
+func toDependencyBodyByFeature(dependencies []FeatureDependencyModel) map[string]unleash.AddFeatureDependencyJSONRequestBody {
+	bodyByFeature := make(map[string]unleash.AddFeatureDependencyJSONRequestBody, len(dependencies))
+	for _, dependency := range dependencies {
+		body := unleash.AddFeatureDependencyJSONRequestBody{
+			Feature: dependency.Feature.ValueString(),
+			Enabled: ptr.ToPtr(dependency.Enabled.IsNull() || dependency.Enabled.ValueBool()),
+		}
+		if len(dependency.Variants) > 0 {
+			variants := toStringValueSlice(dependency.Variants)
+			sort.Strings(variants)
+			body.Variants = &variants
+		}
+		bodyByFeature[body.Feature] = body
+	}
+
+	return bodyByFeature
+}
+
+// updateTags leaves the tags as they are if they are null since they are not managed then.
+func (r *FeatureResource) updateTags(ctx context.Context, featureName string, tags []FeatureTagModel, existingTags []FeatureTagModel) error {
+	if tags == nil {
+		return nil
+	}
+	addedTags := subtractTags(toTagBodies(tags), toTagBodies(existingTags))
+	removedTags := subtractTags(toTagBodies(existingTags), toTagBodies(tags))
+	if len(addedTags) == 0 && len(removedTags) == 0 {
+		return nil
+	}
+
+	// a single change is applied with its dedicated endpoint
+	if len(addedTags) == 1 && len(removedTags) == 0 {
+		tflog.Debug(ctx, "Adding tag", map[string]interface{}{
+			"featureName": featureName,
+			"body":        addedTags[0],
+		})
+		resp, err := r.providerData.Client.AddTagWithResponse(ctx, featureName, addedTags[0])
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode() > 299 {
+			return fmt.Errorf("failed to add tag %s:%s to %s with status %d %s", addedTags[0].Type, addedTags[0].Value, featureName, resp.StatusCode(), string(resp.Body))
 		}
+		return nil
+	}
+	if len(addedTags) == 0 && len(removedTags) == 1 {
+		tflog.Debug(ctx, "Removing tag", map[string]interface{}{
+			"featureName": featureName,
+			"body":        removedTags[0],
+		})
+		resp, err := r.providerData.Client.RemoveTagWithResponse(ctx, featureName, removedTags[0].Type, removedTags[0].Value)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode() > 299 && resp.StatusCode() != 404 {
+			return fmt.Errorf("failed to remove tag %s:%s from %s with status %d %s", removedTags[0].Type, removedTags[0].Value, featureName, resp.StatusCode(), string(resp.Body))
+		}
+		return nil
 	}
 
-	err := r.updateEnvironments(ctx, data.Project.ValueString(), data.Name.ValueString(), data.Environments, existingData.Environments)
+	body := unleash.UpdateTagsJSONRequestBody{
+		AddedTags:   addedTags,
+		RemovedTags: removedTags,
+	}
+	tflog.Debug(ctx, "Updating tags", map[string]interface{}{
+		"featureName": featureName,
+		"body":        body,
+	})
+	resp, err := r.providerData.Client.UpdateTagsWithResponse(ctx, featureName, body)
 	if err != nil {
-		resp.Diagnostics.AddError("failed to update environment", err.Error())
+		return err
+	}
+	if resp.StatusCode() > 299 {
+		return fmt.Errorf("failed to update tags of %s with status %d %s", featureName, resp.StatusCode(), string(resp.Body))
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return nil
+}
+
+func toTagBodies(tags []FeatureTagModel) []unleash.TagSchema {
+	bodies := make([]unleash.TagSchema, 0, len(tags))
+	for _, tag := range tags {
+		bodies = append(bodies, unleash.TagSchema{
+			Type:  tag.Type.ValueString(),
+			Value: tag.Value.ValueString(),
+		})
+	}
+
+	return bodies
+}
+
+func subtractTags(tags []unleash.TagSchema, tagsToSubtract []unleash.TagSchema) []unleash.TagSchema {
+	result := make([]unleash.TagSchema, 0, len(tags))
+	for _, tag := range tags {
+		if !slices.Contains(tagsToSubtract, tag) {
+			result = append(result, tag)
+		}
+	}
+
+	return result
 }
 
 func toFeatureBody(data FeatureResourceModel) unleash.UpdateFeatureJSONRequestBody {
@@ -814,6 +1357,9 @@ func (r *FeatureResource) Delete(ctx context.Context, req resource.DeleteRequest
 		resp.Diagnostics.AddError("failed to archive feature "+data.ID.String(), fmt.Sprintf(" with status %d %s", archiveResp.StatusCode(), string(archiveResp.Body)))
 		return
 	}
+	if r.providerData.FeatureDeleteMode == featureDeleteModeArchive {
+		return
+	}
 	tflog.Debug(ctx, "Deleting feature", map[string]interface{}{"projectID": data.Project.ValueString(), "featureName": data.Name.ValueString()})
 	deleteResp, err := r.providerData.Client.DeleteFeatureWithResponse(ctx, data.Name.ValueString())
 	if err != nil {
@@ -0,0 +1,189 @@
+package provider_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+func TestAccFeatureResourceArchive(t *testing.T) {
+	server := inmem.CreateTestServer()
+	providerConf := fmt.Sprintf(`
+	provider "unleash" {
+		  base_url = "http://localhost:%d"
+		  authorization	= "*:development.x"
+		  feature_delete_mode = "archive"
+	}
+	`, server.Start(t))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_feature" "archived" {
+	project = "default"
+	name = "test-feature.archived"
+	type = "release"
+	stale = true
+	environments = ` + testFeatureDependencyEnvironments + `
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature.archived", "stale", "true"),
+				),
+			},
+			// Archive testing
+			{
+				Config: providerConf,
+				Check: func(_ *terraform.State) error {
+					resp, err := server.GetFeature(context.Background(), unleash.GetFeatureRequestObject{
+						ProjectId:   "default",
+						FeatureName: "test-feature.archived",
+					})
+					if err != nil {
+						return err
+					}
+					feature, ok := resp.(unleash.GetFeature200JSONResponse)
+					if !ok || feature.Archived == nil || !*feature.Archived {
+						return errors.New("the feature should be archived instead of deleted")
+					}
+					return nil
+				},
+			},
+			// Revive testing
+			{
+				Config: providerConf + `
+resource "unleash_feature" "archived" {
+	project = "default"
+	name = "test-feature.archived"
+	type = "experiment"
+	description = "revived"
+	stale = false
+	environments = ` + testFeatureDependencyEnvironments + `
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature.archived", "type", "experiment"),
+					resource.TestCheckResourceAttr("unleash_feature.archived", "description", "revived"),
+					resource.TestCheckResourceAttr("unleash_feature.archived", "stale", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccFeatureResourceReviveOtherProject(t *testing.T) {
+	server := inmem.CreateTestServer()
+	providerConf := getProviderConf(server.Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a feature whose name is used by an archived feature of another project
+			{
+				PreConfig: func() {
+					_, err := server.CreateFeature(context.Background(), unleash.CreateFeatureRequestObject{
+						ProjectId: "other",
+						Body: &unleash.CreateFeatureJSONRequestBody{
+							Name: "test-feature.other-archived",
+							Type: ptr.ToPtr("release"),
+						},
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+					_, err = server.ArchiveFeature(context.Background(), unleash.ArchiveFeatureRequestObject{
+						ProjectId:   "other",
+						FeatureName: "test-feature.other-archived",
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: providerConf + `
+resource "unleash_feature" "archived" {
+	project = "default"
+	name = "test-feature.other-archived"
+	type = "release"
+	environments = ` + testFeatureDependencyEnvironments + `
+}
+`,
+				ExpectError: regexp.MustCompile("failed to create feature"),
+			},
+			// The archived feature of the other project must stay archived
+			{
+				Config: providerConf,
+				Check: func(_ *terraform.State) error {
+					resp, err := server.GetFeature(context.Background(), unleash.GetFeatureRequestObject{
+						ProjectId:   "other",
+						FeatureName: "test-feature.other-archived",
+					})
+					if err != nil {
+						return err
+					}
+					feature, ok := resp.(unleash.GetFeature200JSONResponse)
+					if !ok || feature.Archived == nil || !*feature.Archived {
+						return errors.New("the feature of the other project should not be revived")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccFeatureResourceReviveFailure(t *testing.T) {
+	server := inmem.CreateTestServer()
+	providerConf := getProviderConf(server.Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The revive failure is reported instead of the conflict of creating the feature
+			{
+				PreConfig: func() {
+					_, err := server.CreateFeature(context.Background(), unleash.CreateFeatureRequestObject{
+						ProjectId: "default",
+						Body: &unleash.CreateFeatureJSONRequestBody{
+							Name: "test-feature.revive-failure",
+							Type: ptr.ToPtr("release"),
+						},
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+					_, err = server.ArchiveFeature(context.Background(), unleash.ArchiveFeatureRequestObject{
+						ProjectId:   "default",
+						FeatureName: "test-feature.revive-failure",
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+					server.InjectFault(inmem.Fault{Method: http.MethodPost, PathPrefix: "/api/admin/archive/revive/", StatusCode: 403})
+				},
+				Config: providerConf + `
+resource "unleash_feature" "archived" {
+	project = "default"
+	name = "test-feature.revive-failure"
+	type = "release"
+	environments = ` + testFeatureDependencyEnvironments + `
+}
+`,
+				ExpectError: regexp.MustCompile("failed to revive feature"),
+			},
+		},
+	})
+}
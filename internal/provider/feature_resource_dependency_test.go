@@ -0,0 +1,174 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+func TestAccFeatureResourceDependency(t *testing.T) {
+	server := inmem.CreateTestServer()
+	providerConf := getProviderConf(server.Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConf + testFeatureDependencyParentConf + `
+resource "unleash_feature" "child" {
+	project = "default"
+	name = "test-feature.child"
+	type = "release"
+	environments = ` + testFeatureDependencyEnvironments + `
+	dependencies = [
+		{
+			feature = unleash_feature.parent.name
+			variants = ["blue", "red"]
+		}
+	]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature.child", "dependencies.#", "1"),
+					resource.TestCheckResourceAttr("unleash_feature.child", "dependencies.0.feature", "test-feature.parent"),
+					resource.TestCheckNoResourceAttr("unleash_feature.child", "dependencies.0.enabled"),
+					resource.TestCheckResourceAttr("unleash_feature.child", "dependencies.0.variants.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unleash_feature.child",
+				ImportStateId:     "default.test-feature.child",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"dependencies.0.enabled",
+				},
+			},
+			//	Update and Read testing
+			{
+				Config: providerConf + testFeatureDependencyParentConf + `
+resource "unleash_feature" "child" {
+	project = "default"
+	name = "test-feature.child"
+	type = "release"
+	environments = ` + testFeatureDependencyEnvironments + `
+	dependencies = [
+		{
+			feature = unleash_feature.parent.name
+			enabled = false
+		}
+	]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature.child", "dependencies.#", "1"),
+					resource.TestCheckResourceAttr("unleash_feature.child", "dependencies.0.feature", "test-feature.parent"),
+					resource.TestCheckResourceAttr("unleash_feature.child", "dependencies.0.enabled", "false"),
+					resource.TestCheckNoResourceAttr("unleash_feature.child", "dependencies.0.variants"),
+				),
+			},
+			//	Remove dependencies testing
+			{
+				Config: providerConf + testFeatureDependencyParentConf + `
+resource "unleash_feature" "child" {
+	project = "default"
+	name = "test-feature.child"
+	type = "release"
+	environments = ` + testFeatureDependencyEnvironments + `
+	dependencies = []
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature.child", "dependencies.#", "0"),
+				),
+			},
+			//	Unmanaged dependencies testing
+			{
+				PreConfig: func() {
+					// a dependency added in the UI
+					_, _ = server.AddFeatureDependency(context.Background(), unleash.AddFeatureDependencyRequestObject{
+						ProjectId:   "default",
+						FeatureName: "test-feature.child",
+						Body: &unleash.AddFeatureDependencyJSONRequestBody{
+							Feature: "test-feature.parent",
+						},
+					})
+				},
+				Config: providerConf + testFeatureDependencyParentConf + `
+resource "unleash_feature" "child" {
+	project = "default"
+	name = "test-feature.child"
+	type = "release"
+	environments = ` + testFeatureDependencyEnvironments + `
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("unleash_feature.child", "dependencies"),
+					func(_ *terraform.State) error {
+						resp, err := server.GetFeature(context.Background(), unleash.GetFeatureRequestObject{
+							ProjectId:   "default",
+							FeatureName: "test-feature.child",
+						})
+						if err != nil {
+							return err
+						}
+						feature := resp.(unleash.GetFeature200JSONResponse)
+						if feature.Dependencies == nil || len(*feature.Dependencies) != 1 {
+							return fmt.Errorf("expected the dependency added in the UI to be kept but got %v", feature.Dependencies)
+						}
+						return nil
+					},
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testFeatureDependencyParentConf = `
+resource "unleash_feature" "parent" {
+	project = "default"
+	name = "test-feature.parent"
+	type = "release"
+	environments = ` + testFeatureDependencyEnvironments + `
+}
+`
+
+const testFeatureDependencyEnvironments = `{
+		production = {
+			enabled = false
+			strategies = [
+				{
+					name = "flexibleRollout"
+					disabled = false
+					parameters = {
+						"rollout" = "100"
+						"stickiness" = "default"
+						"groupId" = "dependency"
+					}
+				},
+			]
+		}
+		development = {
+			enabled = true
+			strategies = [
+				{
+					name = "flexibleRollout"
+					disabled = false
+					parameters = {
+						"rollout" = "100"
+						"stickiness" = "default"
+						"groupId" = "dependency"
+					}
+				},
+			]
+		}
+	}`
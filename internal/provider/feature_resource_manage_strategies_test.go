@@ -0,0 +1,94 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+func TestAccFeatureResourceManageStrategies(t *testing.T) {
+	server := inmem.CreateTestServer()
+	providerConf := getProviderConf(server.Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_feature" "switched" {
+	project = "default"
+	name = "test-feature.switched"
+	type = "release"
+	environments = {
+		development = {
+			enabled = true
+			manage_strategies = false
+		}
+		production = {
+			enabled = false
+			manage_strategies = false
+		}
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("unleash_feature.switched", "environments.production.strategies"),
+				),
+			},
+			//	Switching to managed strategies testing
+			{
+				PreConfig: func() {
+					// a strategy added by another resource or in the UI
+					_, _ = server.AddFeatureStrategy(context.Background(), unleash.AddFeatureStrategyRequestObject{
+						ProjectId:   "default",
+						FeatureName: "test-feature.switched",
+						Environment: "production",
+						Body: &unleash.AddFeatureStrategyJSONRequestBody{
+							Name:     "default",
+							Disabled: ptr.ToPtr(false),
+						},
+					})
+				},
+				Config: providerConf + `
+resource "unleash_feature" "switched" {
+	project = "default"
+	name = "test-feature.switched"
+	type = "release"
+	environments = {
+		development = {
+			enabled = true
+			strategies = [
+				{
+					name = "default"
+					disabled = false
+				},
+			]
+		}
+		production = {
+			enabled = false
+			strategies = [
+				{
+					name = "default"
+					disabled = false
+				},
+			]
+		}
+	}
+}
+`,
+				// the existing strategies are replaced rather than added to
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature.switched", "environments.development.strategies.#", "1"),
+					resource.TestCheckResourceAttr("unleash_feature.switched", "environments.production.strategies.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
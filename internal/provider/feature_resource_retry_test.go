@@ -0,0 +1,78 @@
+package provider_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestAccFeatureResourceRetry(t *testing.T) {
+	server := inmem.CreateTestServer()
+	providerConf := fmt.Sprintf(`
+	provider "unleash" {
+		  base_url = "http://localhost:%d"
+		  authorization	= "*:development.x"
+		  max_retries = 5
+		  retry_min_backoff = "1ms"
+		  retry_max_backoff = "10ms"
+	}
+	`, server.Start(t))
+	server.InjectFault(inmem.Fault{
+		StatusCode: http.StatusTooManyRequests,
+		RetryAfter: "0",
+		Every:      5,
+	})
+	server.InjectFault(inmem.Fault{
+		Method:     http.MethodGet,
+		StatusCode: http.StatusBadGateway,
+		Every:      2,
+	})
+	checkInjectedFaults := func(_ *terraform.State) error {
+		if server.InjectedFaults() == 0 {
+			return errors.New("expected faults to be injected")
+		}
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_feature" "retried" {
+	project = "default"
+	name = "test-feature.retried"
+	type = "release"
+	environments = ` + testFeatureDependencyEnvironments + `
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature.retried", "type", "release"),
+					checkInjectedFaults,
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_feature" "retried" {
+	project = "default"
+	name = "test-feature.retried"
+	type = "experiment"
+	environments = ` + testFeatureDependencyEnvironments + `
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature.retried", "type", "experiment"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,74 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+const testFeatureSegmentConf = `
+resource "unleash_segment" "global" {
+	name = "global"
+}
+
+resource "unleash_segment" "default" {
+	project = "default"
+	name = "default"
+}
+
+resource "unleash_segment" "other" {
+	project = "other"
+	name = "other"
+}
+`
+
+func testFeatureSegmentFeatureConf(segments string) string {
+	return `
+resource "unleash_feature" "segmented" {
+	project = "default"
+	name = "test-feature.segmented"
+	type = "release"
+	environments = {
+		development = {
+			enabled = true
+			strategies = [
+				{
+					name = "default"
+					disabled = false
+					segments = ` + segments + `
+				},
+			]
+		}
+	}
+}
+`
+}
+
+func TestAccFeatureResourceSegmentProject(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Segments are created first so that their IDs are known when the feature is planned
+			{
+				Config: providerConf + testFeatureSegmentConf,
+			},
+			// Global segments and segments of the same project
+			{
+				Config: providerConf + testFeatureSegmentConf + testFeatureSegmentFeatureConf("[unleash_segment.global.id_int, unleash_segment.default.id_int]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature.segmented", "environments.development.strategies.0.segments.#", "2"),
+				),
+			},
+			// Segments of another project
+			{
+				Config:      providerConf + testFeatureSegmentConf + testFeatureSegmentFeatureConf("[unleash_segment.other.id_int]"),
+				ExpectError: regexp.MustCompile(`Segment Belongs to Another Project`),
+			},
+		},
+	})
+}
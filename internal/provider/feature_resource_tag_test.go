@@ -0,0 +1,141 @@
+package provider_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+func TestAccFeatureResourceTag(t *testing.T) {
+	server := inmem.CreateTestServer()
+	providerConf := getProviderConf(server.Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConf + testFeatureTagTypeConf + `
+resource "unleash_feature" "tagged" {
+	project = "default"
+	name = "test-feature.tagged"
+	type = "release"
+	environments = ` + testFeatureDependencyEnvironments + `
+	tags = [
+		{
+			type = unleash_tag_type.team.name
+			value = "payments"
+		},
+		{
+			type = "simple"
+			value = "PAY-123"
+		}
+	]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature.tagged", "tags.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("unleash_feature.tagged", "tags.*", map[string]string{
+						"type":  "team",
+						"value": "payments",
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unleash_feature.tagged",
+				ImportStateId:     "default.test-feature.tagged",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			//	Update and Read testing
+			{
+				Config: providerConf + testFeatureTagTypeConf + `
+resource "unleash_feature" "tagged" {
+	project = "default"
+	name = "test-feature.tagged"
+	type = "release"
+	environments = ` + testFeatureDependencyEnvironments + `
+	tags = [
+		{
+			type = unleash_tag_type.team.name
+			value = "checkout"
+		}
+	]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature.tagged", "tags.#", "1"),
+					resource.TestCheckResourceAttr("unleash_feature.tagged", "tags.0.type", "team"),
+					resource.TestCheckResourceAttr("unleash_feature.tagged", "tags.0.value", "checkout"),
+				),
+			},
+			//	Remove tags testing
+			{
+				Config: providerConf + testFeatureTagTypeConf + `
+resource "unleash_feature" "tagged" {
+	project = "default"
+	name = "test-feature.tagged"
+	type = "release"
+	environments = ` + testFeatureDependencyEnvironments + `
+	tags = []
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature.tagged", "tags.#", "0"),
+				),
+			},
+			//	Unmanaged tags testing
+			{
+				PreConfig: func() {
+					// a tag added in the UI
+					_, _ = server.AddTag(context.Background(), unleash.AddTagRequestObject{
+						FeatureName: "test-feature.tagged",
+						Body: &unleash.AddTagJSONRequestBody{
+							Type:  "simple",
+							Value: "PAY-456",
+						},
+					})
+				},
+				Config: providerConf + testFeatureTagTypeConf + `
+resource "unleash_feature" "tagged" {
+	project = "default"
+	name = "test-feature.tagged"
+	type = "release"
+	environments = ` + testFeatureDependencyEnvironments + `
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("unleash_feature.tagged", "tags"),
+					func(_ *terraform.State) error {
+						resp, err := server.GetFeature(context.Background(), unleash.GetFeatureRequestObject{
+							ProjectId:   "default",
+							FeatureName: "test-feature.tagged",
+						})
+						if err != nil {
+							return err
+						}
+						feature := resp.(unleash.GetFeature200JSONResponse)
+						if feature.Tags == nil || len(*feature.Tags) != 1 {
+							return fmt.Errorf("expected the tag added in the UI to be kept but got %v", feature.Tags)
+						}
+						return nil
+					},
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testFeatureTagTypeConf = `
+resource "unleash_tag_type" "team" {
+	name = "team"
+}
+`
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type FeatureStrategyModel struct {
+	ID          types.String            `tfsdk:"id"`
+	Project     types.String            `tfsdk:"project"`
+	Feature     types.String            `tfsdk:"feature"`
+	Environment types.String            `tfsdk:"environment"`
+	Name        types.String            `tfsdk:"name"`
+	Disabled    types.Bool              `tfsdk:"disabled"`
+	Title       types.String            `tfsdk:"title"`
+	SortOrder   types.Float32           `tfsdk:"sort_order"`
+	Constraints []ConstraintModel       `tfsdk:"constraints"`
+	Parameters  map[string]types.String `tfsdk:"parameters"`
+	Segments    []types.Float32         `tfsdk:"segments"`
+	Variants    []StrategyVariantModel  `tfsdk:"variants"`
+}
+
+func createFeatureStrategyResourceSchemaAttr() map[string]schema.Attribute {
+	attrs := withSegmentsOfFeatureProject(createStrategyResourceSchemaAttrs())
+	attrs["id"] = schema.StringAttribute{
+		Description: "ID of this strategy which Unleash generates",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attrs["project"] = schema.StringAttribute{
+		Description: "The name of project the feature belongs to",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attrs["feature"] = schema.StringAttribute{
+		Description: "The name of the feature",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attrs["environment"] = schema.StringAttribute{
+		Description: "The name of the environment. manage_strategies of the environment in unleash_feature must be false",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	return attrs
+}
+
+func toFeatureStrategyModel(projectID string, featureName string, environmentID string, strategyModel StrategyModel) FeatureStrategyModel {
+	return FeatureStrategyModel{
+		ID:          strategyModel.Id,
+		Project:     types.StringValue(projectID),
+		Feature:     types.StringValue(featureName),
+		Environment: types.StringValue(environmentID),
+		Name:        strategyModel.Name,
+		Disabled:    strategyModel.Disabled,
+		Title:       strategyModel.Title,
+		SortOrder:   strategyModel.SortOrder,
+		Constraints: strategyModel.Constraints,
+		Parameters:  strategyModel.Parameters,
+		Segments:    strategyModel.Segments,
+		Variants:    strategyModel.Variants,
+	}
+}
+
+func toStrategyModelFromFeatureStrategy(strategy FeatureStrategyModel) StrategyModel {
+	return StrategyModel{
+		Id:          strategy.ID,
+		Name:        strategy.Name,
+		Disabled:    strategy.Disabled,
+		Title:       strategy.Title,
+		SortOrder:   strategy.SortOrder,
+		Constraints: strategy.Constraints,
+		Parameters:  strategy.Parameters,
+		Segments:    strategy.Segments,
+		Variants:    strategy.Variants,
+	}
+}
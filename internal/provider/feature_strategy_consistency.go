@@ -0,0 +1,8 @@
+package provider
+
+func ensureFeatureStrategyModelNullAndEmptyConsistency(featureStrategyModel *FeatureStrategyModel, featureStrategyModelBefore FeatureStrategyModel) {
+	strategy := toStrategyModelFromFeatureStrategy(*featureStrategyModel)
+	ensureStrategyNullAndEmptyConsistency(&strategy, toStrategyModelFromFeatureStrategy(featureStrategyModelBefore))
+	*featureStrategyModel = toFeatureStrategyModel(featureStrategyModel.Project.ValueString(), featureStrategyModel.Feature.ValueString(),
+		featureStrategyModel.Environment.ValueString(), strategy)
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &FeatureStrategyResource{}
+var _ resource.ResourceWithImportState = &FeatureStrategyResource{}
+var _ resource.ResourceWithModifyPlan = &FeatureStrategyResource{}
+
+func NewFeatureStrategyResource() resource.Resource {
+	return &FeatureStrategyResource{}
+}
+
+type FeatureStrategyResource struct {
+	providerData UnleashProviderData
+}
+
+type FeatureStrategyResourceModel struct {
+	FeatureStrategyModel
+}
+
+func (r *FeatureStrategyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_strategy"
+}
+
+func (r *FeatureStrategyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Feature strategy resource which manages a single strategy of a feature in an environment. " +
+			"Set `manage_strategies = false` for the environment in `unleash_feature` so that both resources do not fight over the strategies. " +
+			"A strategy is imported by `<project>/<feature>/<environment>/<strategy ID>`.",
+
+		Attributes: createFeatureStrategyResourceSchemaAttr(),
+	}
+}
+
+func (r *FeatureStrategyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *FeatureStrategyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to validate when the strategy is destroyed or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.providerData.Client == nil {
+		return
+	}
+
+	var projectID types.String
+	var segments types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project"), &projectID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("segments"), &segments)...)
+	if resp.Diagnostics.HasError() || projectID.IsUnknown() {
+		return
+	}
+	var priorReferences []segmentReference
+	if !req.State.Raw.IsNull() {
+		var priorProjectID types.String
+		var priorSegments types.Set
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("project"), &priorProjectID)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("segments"), &priorSegments)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if priorProjectID.Equal(projectID) {
+			priorReferences = collectSegmentReferences(priorSegments, path.Root("segments"))
+		}
+	}
+	validateSegmentProjects(ctx, r.providerData.Client, projectID.ValueString(), collectSegmentReferences(segments, path.Root("segments")), priorReferences, &resp.Diagnostics)
+}
+
+func (r *FeatureStrategyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FeatureStrategyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Adding strategy", map[string]interface{}{
+		"projectID":     data.Project.ValueString(),
+		"featureName":   data.Feature.ValueString(),
+		"environmentID": data.Environment.ValueString(),
+		"strategy":      data.Name.ValueString(),
+	})
+	id, err := addStrategy(ctx, r.providerData.Client, data.Project.ValueString(), data.Feature.ValueString(), data.Environment.ValueString(),
+		toStrategyModelFromFeatureStrategy(data.FeatureStrategyModel))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create strategy "+data.Name.String(), err.Error())
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FeatureStrategyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FeatureStrategyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading strategy", map[string]interface{}{
+		"projectID":     data.Project.ValueString(),
+		"featureName":   data.Feature.ValueString(),
+		"environmentID": data.Environment.ValueString(),
+		"strategyID":    data.ID.ValueString(),
+	})
+	readResp, err := r.providerData.Client.GetFeatureStrategyWithResponse(ctx, data.Project.ValueString(), data.Feature.ValueString(), data.Environment.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read strategy "+data.ID.String(), err.Error())
+		return
+	}
+	if readResp.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if readResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to read strategy "+data.ID.String(), fmt.Sprintf(" with status %d %s", readResp.StatusCode(), string(readResp.Body)))
+		return
+	}
+	strategyModel, err := toStrategyModel(*readResp.JSON200)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to convert strategy "+data.ID.String(), err.Error())
+		return
+	}
+	featureStrategyModel := toFeatureStrategyModel(data.Project.ValueString(), data.Feature.ValueString(), data.Environment.ValueString(), strategyModel)
+	ensureFeatureStrategyModelNullAndEmptyConsistency(&featureStrategyModel, data.FeatureStrategyModel)
+	data.FeatureStrategyModel = featureStrategyModel
+
+	tflog.Trace(ctx, "read resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FeatureStrategyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FeatureStrategyResourceModel
+	var existingData FeatureStrategyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &existingData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := updateStrategy(ctx, r.providerData.Client, data.Project.ValueString(), data.Feature.ValueString(), data.Environment.ValueString(),
+		toStrategyModelFromFeatureStrategy(data.FeatureStrategyModel), toStrategyModelFromFeatureStrategy(existingData.FeatureStrategyModel))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update strategy "+data.ID.String(), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FeatureStrategyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FeatureStrategyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteStrategy(ctx, r.providerData.Client, data.Project.ValueString(), data.Feature.ValueString(), data.Environment.ValueString(),
+		toStrategyModelFromFeatureStrategy(data.FeatureStrategyModel))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete strategy "+data.ID.String(), err.Error())
+		return
+	}
+}
+
+// ImportState accepts `<project>/<feature>/<environment>/<strategy ID>`. Feature and environment names may contain dots but not slashes.
+func (r *FeatureStrategyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 4 || slices.Contains(parts, "") {
+		resp.Diagnostics.AddError("Invalid Feature Strategy Import ID",
+			fmt.Sprintf("Expected <project>/<feature>/<environment>/<strategy ID> but got %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("feature"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[3])...)
+}
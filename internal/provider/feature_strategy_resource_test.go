@@ -0,0 +1,175 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestAccFeatureStrategyResource(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+	featureConf := `
+resource "unleash_feature" "shared" {
+	project = "default"
+	name = "test-feature.shared"
+	type = "release"
+	environments = {
+		development = {
+			enabled = true
+			strategies = [
+				{
+					name = "default"
+					disabled = false
+				},
+			]
+		}
+		production = {
+			enabled = true
+			manage_strategies = false
+		}
+	}
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConf + featureConf + `
+resource "unleash_feature_strategy" "rollout" {
+	project = unleash_feature.shared.project
+	feature = unleash_feature.shared.name
+	environment = "production"
+	name = "flexibleRollout"
+	title = "Platform rollout"
+	disabled = false
+	parameters = {
+		rollout = "50"
+		stickiness = "default"
+		groupId = "test-feature.shared"
+	}
+}
+
+resource "unleash_feature_strategy" "internal" {
+	project = unleash_feature.shared.project
+	feature = unleash_feature.shared.name
+	environment = "production"
+	name = "default"
+	disabled = false
+	constraints = [
+		{
+			context_name = "userId"
+			operator = "IN"
+			values_json = jsonencode(["internal"])
+		},
+	]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unleash_feature_strategy.rollout", "id"),
+					resource.TestCheckResourceAttr("unleash_feature_strategy.rollout", "name", "flexibleRollout"),
+					resource.TestCheckResourceAttr("unleash_feature_strategy.rollout", "title", "Platform rollout"),
+					resource.TestCheckResourceAttr("unleash_feature_strategy.rollout", "parameters.rollout", "50"),
+					resource.TestCheckResourceAttr("unleash_feature_strategy.internal", "constraints.0.context_name", "userId"),
+					// the feature does not manage the strategies of production
+					resource.TestCheckResourceAttr("unleash_feature.shared", "environments.production.manage_strategies", "false"),
+					resource.TestCheckNoResourceAttr("unleash_feature.shared", "environments.production.strategies"),
+					resource.TestCheckResourceAttr("unleash_feature.shared", "environments.development.strategies.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "unleash_feature_strategy.rollout",
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return "default/test-feature.shared/production/" + state.RootModule().Resources["unleash_feature_strategy.rollout"].Primary.Attributes["id"], nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			//	Update and Read testing
+			{
+				Config: providerConf + featureConf + `
+resource "unleash_feature_strategy" "rollout" {
+	project = unleash_feature.shared.project
+	feature = unleash_feature.shared.name
+	environment = "production"
+	name = "flexibleRollout"
+	title = "Platform rollout"
+	disabled = false
+	sort_order = 2
+	parameters = {
+		rollout = "100"
+		stickiness = "default"
+		groupId = "test-feature.shared"
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_strategy.rollout", "parameters.rollout", "100"),
+					resource.TestCheckResourceAttr("unleash_feature_strategy.rollout", "sort_order", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccFeatureStrategyResourceSegmentProject(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+	featureConf := `
+resource "unleash_feature" "segmented" {
+	project = "default"
+	name = "test-feature.segmented"
+	type = "release"
+	environments = {
+		development = {
+			enabled = true
+			manage_strategies = false
+		}
+		production = {
+			enabled = true
+			manage_strategies = false
+		}
+	}
+}
+`
+	strategyConf := func(segments string) string {
+		return `
+resource "unleash_feature_strategy" "segmented" {
+	project = unleash_feature.segmented.project
+	feature = unleash_feature.segmented.name
+	environment = "production"
+	name = "default"
+	disabled = false
+	segments = ` + segments + `
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Segments are created first so that their IDs are known when the strategy is planned
+			{
+				Config: providerConf + testFeatureSegmentConf + featureConf,
+			},
+			// Global segments and segments of the same project
+			{
+				Config: providerConf + testFeatureSegmentConf + featureConf + strategyConf("[unleash_segment.global.id_int, unleash_segment.default.id_int]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_strategy.segmented", "segments.#", "2"),
+				),
+			},
+			// Segments of another project
+			{
+				Config:      providerConf + testFeatureSegmentConf + featureConf + strategyConf("[unleash_segment.other.id_int]"),
+				ExpectError: regexp.MustCompile(`Segment Belongs to Another Project`),
+			},
+		},
+	})
+}
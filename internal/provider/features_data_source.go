@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+var _ datasource.DataSource = &FeaturesDataSource{}
+
+func NewFeaturesDataSource() datasource.DataSource {
+	return &FeaturesDataSource{}
+}
+
+type FeaturesDataSource struct {
+	providerData UnleashProviderData
+}
+
+type FeaturesDataSourceModel struct {
+	Project  types.String             `tfsdk:"project"`
+	Type     types.String             `tfsdk:"type"`
+	Tag      *FeatureTagModel         `tfsdk:"tag"`
+	Features []FeatureDataSourceModel `tfsdk:"features"`
+}
+
+func (d *FeaturesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_features"
+}
+
+func (d *FeaturesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Features data source",
+
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "The name of project the features belong to",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return features of this type e.g. release",
+				Optional:    true,
+			},
+			"tag": schema.SingleNestedAttribute{
+				Description: "Only return features which have this tag",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "The name of the tag type",
+						Required:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "The value of the tag",
+						Required:    true,
+					},
+				},
+			},
+			"features": schema.ListNestedAttribute{
+				Description: "The matched features sorted by name",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: createFeatureDataSourceSchemaAttr(false),
+				},
+			},
+		},
+	}
+}
+
+func (d *FeaturesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *FeaturesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FeaturesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading features", map[string]interface{}{"projectID": data.Project.ValueString()})
+	featuresResp, err := d.providerData.Client.GetFeaturesWithResponse(ctx, data.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get features", err.Error())
+		return
+	}
+	if featuresResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to get features", fmt.Sprintf(" with status %d %s", featuresResp.StatusCode(), string(featuresResp.Body)))
+		return
+	}
+	features := featuresResp.JSON200.Features
+	sort.Slice(features, func(i, j int) bool {
+		return features[i].Name < features[j].Name
+	})
+
+	data.Features = make([]FeatureDataSourceModel, 0, len(features))
+	for _, feature := range features {
+		if !isFeatureMatched(feature, data) {
+			continue
+		}
+		data.Features = append(data.Features, toFeatureDataSourceModel(data.Project.ValueString(), feature))
+	}
+
+	tflog.Trace(ctx, "read data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func isFeatureMatched(feature unleash.FeatureSchema, filter FeaturesDataSourceModel) bool {
+	if feature.Archived != nil && *feature.Archived {
+		return false
+	}
+	if !filter.Type.IsNull() && (feature.Type == nil || *feature.Type != filter.Type.ValueString()) {
+		return false
+	}
+	if filter.Tag != nil {
+		tag := unleash.TagSchema{
+			Type:  filter.Tag.Type.ValueString(),
+			Value: filter.Tag.Value.ValueString(),
+		}
+		if feature.Tags == nil || !slices.Contains(*feature.Tags, tag) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+type PersonalAccessTokenModel struct {
+	ID          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+	Secret      types.String `tfsdk:"secret"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+func createPersonalAccessTokenResourceSchemaAttr() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "ID of this token",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"description": schema.StringAttribute{
+			Description: "A description of what this token is for. Changing it creates a new token.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"expires_at": schema.StringAttribute{
+			Description: "The time when this token expires in RFC 3339 format e.g. 2030-01-02T15:04:05Z. Changing it creates a new token.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"secret": schema.StringAttribute{
+			Description: "The generated token which is used for authentication. Unleash only returns it when the token is created so it is null for imported tokens",
+			Computed:    true,
+			Sensitive:   true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The time when this token was created",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func toPersonalAccessTokenModel(pat unleash.PatSchema) PersonalAccessTokenModel {
+	patModel := PersonalAccessTokenModel{}
+	if pat.Id != nil {
+		patModel.ID = types.StringValue(strconv.Itoa(*pat.Id))
+	}
+	if pat.Description != nil {
+		patModel.Description = types.StringValue(*pat.Description)
+	}
+	if pat.ExpiresAt != nil {
+		patModel.ExpiresAt = types.StringValue(pat.ExpiresAt.Format(time.RFC3339))
+	}
+	if pat.Secret != nil {
+		patModel.Secret = types.StringValue(*pat.Secret)
+	}
+	if pat.CreatedAt != nil {
+		patModel.CreatedAt = types.StringValue(pat.CreatedAt.Format(time.RFC3339))
+	}
+
+	return patModel
+}
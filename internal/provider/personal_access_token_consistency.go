@@ -0,0 +1,13 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+func ensurePersonalAccessTokenModelNullAndEmptyConsistency(patModel *PersonalAccessTokenModel, patModelBefore PersonalAccessTokenModel) {
+	// Unleash only returns the secret when the token is created
+	if patModel.Secret.IsNull() {
+		patModel.Secret = patModelBefore.Secret
+	}
+	tryKeepSameInstant(patModel.ExpiresAt, patModelBefore.ExpiresAt, func(value types.String) {
+		patModel.ExpiresAt = value
+	})
+}
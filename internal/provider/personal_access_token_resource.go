@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+var _ resource.Resource = &PersonalAccessTokenResource{}
+var _ resource.ResourceWithImportState = &PersonalAccessTokenResource{}
+
+func NewPersonalAccessTokenResource() resource.Resource {
+	return &PersonalAccessTokenResource{}
+}
+
+type PersonalAccessTokenResource struct {
+	providerData UnleashProviderData
+}
+
+type PersonalAccessTokenResourceModel struct {
+	PersonalAccessTokenModel
+}
+
+func (r *PersonalAccessTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_personal_access_token"
+}
+
+func (r *PersonalAccessTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Personal access token resource. The token belongs to the user whom the provider authenticates as, " +
+			"so the provider must be configured with a personal access token rather than an admin API token. " +
+			"A token is imported by its ID.",
+
+		Attributes: createPersonalAccessTokenResourceSchemaAttr(),
+	}
+}
+
+func (r *PersonalAccessTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *PersonalAccessTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PersonalAccessTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, data.ExpiresAt.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create personal access token "+data.Description.String(), fmt.Sprintf("invalid expires_at %s: %s", data.ExpiresAt.ValueString(), err.Error()))
+		return
+	}
+	body := unleash.CreatePatJSONRequestBody{
+		Description: data.Description.ValueString(),
+		ExpiresAt:   expiresAt,
+	}
+
+	tflog.Debug(ctx, "Creating personal access token", map[string]interface{}{"body": body})
+	createResp, err := r.providerData.Client.CreatePatWithResponse(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create personal access token "+data.Description.String(), err.Error())
+		return
+	}
+	if createResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to create personal access token "+data.Description.String(), fmt.Sprintf(" with status %d %s", createResp.StatusCode(), string(createResp.Body)))
+		return
+	}
+	patModel := toPersonalAccessTokenModel(*createResp.JSON201)
+	ensurePersonalAccessTokenModelNullAndEmptyConsistency(&patModel, data.PersonalAccessTokenModel)
+	data.PersonalAccessTokenModel = patModel
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersonalAccessTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PersonalAccessTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading personal access token", map[string]interface{}{"id": data.ID.ValueString()})
+	readResp, err := r.providerData.Client.GetPatsWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get personal access tokens", err.Error())
+		return
+	}
+	if readResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to read personal access token "+data.ID.String(), fmt.Sprintf(" with status %d %s", readResp.StatusCode(), string(readResp.Body)))
+		return
+	}
+	var pat *unleash.PatSchema
+	if readResp.JSON200.Pats != nil {
+		for _, p := range *readResp.JSON200.Pats {
+			if p.Id != nil && strconv.Itoa(*p.Id) == data.ID.ValueString() {
+				pat = &p
+				break
+			}
+		}
+	}
+	if pat == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	patModel := toPersonalAccessTokenModel(*pat)
+	ensurePersonalAccessTokenModelNullAndEmptyConsistency(&patModel, data.PersonalAccessTokenModel)
+	data.PersonalAccessTokenModel = patModel
+
+	tflog.Trace(ctx, "read resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersonalAccessTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PersonalAccessTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// all attributes require replacement since Unleash cannot update personal access tokens
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersonalAccessTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PersonalAccessTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting personal access token", map[string]interface{}{"id": data.ID.ValueString()})
+	deleteResp, err := r.providerData.Client.DeletePatWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete personal access token "+data.ID.String(), err.Error())
+		return
+	}
+	if deleteResp.StatusCode() > 299 && deleteResp.StatusCode() != 404 {
+		resp.Diagnostics.AddError("failed to delete personal access token "+data.ID.String(), fmt.Sprintf(" with status %d %s", deleteResp.StatusCode(), string(deleteResp.Body)))
+		return
+	}
+}
+
+func (r *PersonalAccessTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,57 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestAccPersonalAccessTokenResource(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_personal_access_token" "ci" {
+	description = "CI"
+	expires_at = "2030-01-01T00:00:00Z"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unleash_personal_access_token.ci", "id"),
+					resource.TestCheckResourceAttr("unleash_personal_access_token.ci", "description", "CI"),
+					resource.TestCheckResourceAttr("unleash_personal_access_token.ci", "expires_at", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrSet("unleash_personal_access_token.ci", "secret"),
+					resource.TestCheckResourceAttrSet("unleash_personal_access_token.ci", "created_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unleash_personal_access_token.ci",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Unleash only returns the secret on creation
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+			//	Update and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_personal_access_token" "ci" {
+	description = "CI"
+	expires_at = "2031-01-01T00:00:00Z"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_personal_access_token.ci", "expires_at", "2031-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrSet("unleash_personal_access_token.ci", "secret"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+type ProjectModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Description       types.String   `tfsdk:"description"`
+	Mode              types.String   `tfsdk:"mode"`
+	DefaultStickiness types.String   `tfsdk:"default_stickiness"`
+	Environments      []types.String `tfsdk:"environments"`
+}
+
+func createProjectResourceSchemaAttr() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "ID of this project. This cannot be changed after the project is created.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of this project",
+			Required:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "A description of what the project is for",
+			Optional:    true,
+		},
+		"mode": schema.StringAttribute{
+			Description: "Collaboration mode of this project (open, protected, private). Unleash uses open if it is not specified.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"default_stickiness": schema.StringAttribute{
+			Description: "Default stickiness for variants and gradual rollout strategies of this project. Unleash uses default if it is not specified.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"environments": schema.SetAttribute{
+			Description: "Environments enabled for this project. Unleash enables all non-deprecated environments if it is not specified and the enabled environments are not managed by this resource.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func toProjectModel(projectID string, project *unleash.ProjectOverviewSchema) ProjectModel {
+	projectModel := ProjectModel{
+		ID:   types.StringValue(projectID),
+		Name: types.StringValue(project.Name),
+	}
+	if project.Description != nil && *project.Description != "" {
+		projectModel.Description = types.StringValue(*project.Description)
+	}
+	if project.Mode != nil {
+		projectModel.Mode = types.StringValue(string(*project.Mode))
+	}
+	if project.DefaultStickiness != nil {
+		projectModel.DefaultStickiness = types.StringValue(*project.DefaultStickiness)
+	}
+	if project.Environments != nil {
+		for _, environment := range *project.Environments {
+			projectModel.Environments = append(projectModel.Environments, types.StringValue(environment.Environment))
+		}
+	}
+
+	return projectModel
+}
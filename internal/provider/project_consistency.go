@@ -0,0 +1,15 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+func ensureProjectModelNullAndEmptyConsistency(projectModel *ProjectModel, projectModelBefore ProjectModel) {
+	tryUpdateToEmptyStringIfBeforeEmpty(projectModel.Description, projectModelBefore.Description, func(value types.String) {
+		projectModel.Description = value
+	})
+	if projectModelBefore.Environments == nil && !projectModelBefore.Name.IsNull() {
+		// environments are not managed by this resource
+		projectModel.Environments = nil
+	} else if isNullArrayAndExistingEmptyArray(projectModel.Environments, projectModelBefore.Environments) {
+		projectModel.Environments = []types.String{}
+	}
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &ProjectDataSource{}
+
+func NewProjectDataSource() datasource.DataSource {
+	return &ProjectDataSource{}
+}
+
+type ProjectDataSource struct {
+	providerData UnleashProviderData
+}
+
+type ProjectDataSourceModel struct {
+	ProjectModel
+}
+
+func (d *ProjectDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (d *ProjectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Project data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the project",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of this project",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of what the project is for",
+				Computed:    true,
+			},
+			"mode": schema.StringAttribute{
+				Description: "Collaboration mode of this project (open, protected, private)",
+				Computed:    true,
+			},
+			"default_stickiness": schema.StringAttribute{
+				Description: "Default stickiness for variants and gradual rollout strategies of this project",
+				Computed:    true,
+			},
+			"environments": schema.SetAttribute{
+				Description: "Environments enabled for this project",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *ProjectDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading project", map[string]interface{}{"id": data.ID.ValueString()})
+	readResp, err := d.providerData.Client.GetProjectOverviewWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get project", err.Error())
+		return
+	}
+	if readResp.StatusCode() == 404 {
+		resp.Diagnostics.AddError("failed to get project", fmt.Sprintf("project %s is not found", data.ID.ValueString()))
+		return
+	}
+	if readResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to read project "+data.ID.String(), fmt.Sprintf(" with status %d %s", readResp.StatusCode(), string(readResp.Body)))
+		return
+	}
+	data.ProjectModel = toProjectModel(data.ID.ValueString(), readResp.JSON200)
+
+	tflog.Trace(ctx, "read data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,32 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestAccProjectDataSource(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConf + `
+data "unleash_project" "default" {
+	id = "default"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unleash_project.default", "name", "Default"),
+					resource.TestCheckResourceAttr("data.unleash_project.default", "description", "Default project"),
+					resource.TestCheckResourceAttr("data.unleash_project.default", "mode", "open"),
+					resource.TestCheckResourceAttr("data.unleash_project.default", "environments.#", "2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+type ProjectEnvironmentModel struct {
+	ID              types.String          `tfsdk:"id"`
+	Project         types.String          `tfsdk:"project"`
+	Environment     types.String          `tfsdk:"environment"`
+	DefaultStrategy *DefaultStrategyModel `tfsdk:"default_strategy"`
+}
+
+type DefaultStrategyModel struct {
+	Name        types.String            `tfsdk:"name"`
+	Disabled    types.Bool              `tfsdk:"disabled"`
+	Title       types.String            `tfsdk:"title"`
+	SortOrder   types.Float32           `tfsdk:"sort_order"`
+	Constraints []ConstraintModel       `tfsdk:"constraints"`
+	Parameters  map[string]types.String `tfsdk:"parameters"`
+	Segments    []types.Float32         `tfsdk:"segments"`
+	Variants    []StrategyVariantModel  `tfsdk:"variants"`
+}
+
+func createProjectEnvironmentResourceSchemaAttr() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "ID which is a combination of project , `.` and environment name. e.g. default.development",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				createFeatureIDPlanModifier("project", "environment"),
+			},
+		},
+		"project": schema.StringAttribute{
+			Description: "The ID of the project",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"environment": schema.StringAttribute{
+			Description: "The name of the environment to be enabled in the project",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"default_strategy": schema.SingleNestedAttribute{
+			Description: "The strategy which Unleash adds when a feature is enabled in this environment of the project. " +
+				"The default strategy is not managed by this resource if it is not specified.",
+			Optional:   true,
+			Attributes: createDefaultStrategyResourceSchemaAttrs(),
+		},
+	}
+}
+
+func createDefaultStrategyResourceSchemaAttrs() map[string]schema.Attribute {
+	attrs := createStrategyResourceSchemaAttrs()
+	// a default strategy is only a template, so it has no ID
+	delete(attrs, "id")
+
+	return attrs
+}
+
+func toProjectEnvironmentModel(projectID string, environment unleash.ProjectEnvironmentSchema) (ProjectEnvironmentModel, error) {
+	projectEnvironmentModel := ProjectEnvironmentModel{
+		ID:          types.StringValue(projectID + "." + environment.Environment),
+		Project:     types.StringValue(projectID),
+		Environment: types.StringValue(environment.Environment),
+	}
+	if environment.DefaultStrategy != nil {
+		defaultStrategyModel, err := toDefaultStrategyModel(*environment.DefaultStrategy)
+		if err != nil {
+			return projectEnvironmentModel, err
+		}
+		projectEnvironmentModel.DefaultStrategy = &defaultStrategyModel
+	}
+
+	return projectEnvironmentModel, nil
+}
+
+func toDefaultStrategyModel(strategy unleash.CreateFeatureStrategySchema) (DefaultStrategyModel, error) {
+	// the variants of a default strategy have a different generated type, so go through JSON to reuse toStrategyModel
+	b, err := json.Marshal(strategy)
+	if err != nil {
+		return DefaultStrategyModel{}, err
+	}
+	var featureStrategy unleash.FeatureStrategySchema
+	err = json.Unmarshal(b, &featureStrategy)
+	if err != nil {
+		return DefaultStrategyModel{}, err
+	}
+	if featureStrategy.Disabled == nil {
+		disabled := false
+		featureStrategy.Disabled = &disabled
+	}
+	strategyModel, err := toStrategyModel(featureStrategy)
+	if err != nil {
+		return DefaultStrategyModel{}, err
+	}
+
+	return toDefaultStrategyModelFromStrategy(strategyModel), nil
+}
+
+func toDefaultStrategyModelFromStrategy(strategyModel StrategyModel) DefaultStrategyModel {
+	return DefaultStrategyModel{
+		Name:        strategyModel.Name,
+		Disabled:    strategyModel.Disabled,
+		Title:       strategyModel.Title,
+		SortOrder:   strategyModel.SortOrder,
+		Constraints: strategyModel.Constraints,
+		Parameters:  strategyModel.Parameters,
+		Segments:    strategyModel.Segments,
+		Variants:    strategyModel.Variants,
+	}
+}
+
+func toStrategyModelFromDefaultStrategy(strategy DefaultStrategyModel) StrategyModel {
+	return StrategyModel{
+		Name:        strategy.Name,
+		Disabled:    strategy.Disabled,
+		Title:       strategy.Title,
+		SortOrder:   strategy.SortOrder,
+		Constraints: strategy.Constraints,
+		Parameters:  strategy.Parameters,
+		Segments:    strategy.Segments,
+		Variants:    strategy.Variants,
+	}
+}
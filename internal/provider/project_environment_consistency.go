@@ -0,0 +1,16 @@
+package provider
+
+func ensureProjectEnvironmentModelNullAndEmptyConsistency(projectEnvironmentModel *ProjectEnvironmentModel, projectEnvironmentModelBefore ProjectEnvironmentModel) {
+	if projectEnvironmentModelBefore.DefaultStrategy == nil && !projectEnvironmentModelBefore.Project.IsNull() {
+		// default strategy is not managed by this resource
+		projectEnvironmentModel.DefaultStrategy = nil
+		return
+	}
+	if projectEnvironmentModel.DefaultStrategy == nil || projectEnvironmentModelBefore.DefaultStrategy == nil {
+		return
+	}
+	strategy := toStrategyModelFromDefaultStrategy(*projectEnvironmentModel.DefaultStrategy)
+	ensureStrategyNullAndEmptyConsistency(&strategy, toStrategyModelFromDefaultStrategy(*projectEnvironmentModelBefore.DefaultStrategy))
+	defaultStrategy := toDefaultStrategyModelFromStrategy(strategy)
+	projectEnvironmentModel.DefaultStrategy = &defaultStrategy
+}
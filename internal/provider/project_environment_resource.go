@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+var _ resource.Resource = &ProjectEnvironmentResource{}
+var _ resource.ResourceWithImportState = &ProjectEnvironmentResource{}
+
+func NewProjectEnvironmentResource() resource.Resource {
+	return &ProjectEnvironmentResource{}
+}
+
+type ProjectEnvironmentResource struct {
+	providerData UnleashProviderData
+}
+
+type ProjectEnvironmentResourceModel struct {
+	ProjectEnvironmentModel
+}
+
+func (r *ProjectEnvironmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_environment"
+}
+
+func (r *ProjectEnvironmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Project environment resource. It enables an environment in a project. " +
+			"Do not combine it with `environments` of `unleash_project` for the same project.",
+
+		Attributes: createProjectEnvironmentResourceSchemaAttr(),
+	}
+}
+
+func (r *ProjectEnvironmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *ProjectEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(data.Project.ValueString() + "." + data.Environment.ValueString())
+
+	body := unleash.AddEnvironmentToProjectJSONRequestBody{
+		Environment: data.Environment.ValueString(),
+	}
+
+	tflog.Debug(ctx, "Adding environment to project", map[string]interface{}{
+		"projectID": data.Project.ValueString(),
+		"body":      body,
+	})
+	addResp, err := r.providerData.Client.AddEnvironmentToProjectWithResponse(ctx, data.Project.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create project environment "+data.ID.String(), err.Error())
+		return
+	}
+	if addResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to create project environment "+data.ID.String(), fmt.Sprintf(" with status %d %s", addResp.StatusCode(), string(addResp.Body)))
+		return
+	}
+
+	if data.DefaultStrategy != nil {
+		err = r.updateDefaultStrategy(ctx, data.Project.ValueString(), data.Environment.ValueString(), *data.DefaultStrategy)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to create project environment "+data.ID.String(), err.Error())
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectEnvironmentResource) updateDefaultStrategy(ctx context.Context, projectID string, environmentID string, strategy DefaultStrategyModel) error {
+	body, err := toAddStrategyBody(toStrategyModelFromDefaultStrategy(strategy))
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "Updating default strategy of project environment", map[string]interface{}{
+		"projectID":     projectID,
+		"environmentID": environmentID,
+		"body":          body,
+	})
+	resp, err := r.providerData.Client.AddDefaultStrategyToProjectEnvironmentWithResponse(ctx, projectID, environmentID, body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() > 299 {
+		return fmt.Errorf("failed to update default strategy of %s %s with status %d %s", projectID, environmentID, resp.StatusCode(), string(resp.Body))
+	}
+
+	return nil
+}
+
+func (r *ProjectEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, environmentID := extractProjectAndEnvironmentName(data)
+	tflog.Debug(ctx, "Reading project environment", map[string]interface{}{"id": data.ID.ValueString()})
+	readResp, err := r.providerData.Client.GetProjectOverviewWithResponse(ctx, projectID)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get project environment", err.Error())
+		return
+	}
+	if readResp.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if readResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to read project environment "+data.ID.String(), fmt.Sprintf(" with status %d %s", readResp.StatusCode(), string(readResp.Body)))
+		return
+	}
+	var projectEnvironment *unleash.ProjectEnvironmentSchema
+	if readResp.JSON200.Environments != nil {
+		for _, environment := range *readResp.JSON200.Environments {
+			if environment.Environment == environmentID {
+				projectEnvironment = &environment
+				break
+			}
+		}
+	}
+	if projectEnvironment == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	projectEnvironmentModel, err := toProjectEnvironmentModel(projectID, *projectEnvironment)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read project environment "+data.ID.String(), err.Error())
+		return
+	}
+	ensureProjectEnvironmentModelNullAndEmptyConsistency(&projectEnvironmentModel, data.ProjectEnvironmentModel)
+	data.ProjectEnvironmentModel = projectEnvironmentModel
+
+	tflog.Trace(ctx, "read resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func extractProjectAndEnvironmentName(data ProjectEnvironmentResourceModel) (string, string) {
+	id := data.ID.ValueString()
+	firstDot := strings.Index(id, ".")
+
+	return id[0:firstDot], id[firstDot+1:]
+}
+
+func (r *ProjectEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectEnvironmentResourceModel
+	var existingData ProjectEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &existingData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// removing the default strategy from the configuration only stops managing it
+	if data.DefaultStrategy != nil && !cmp.Equal(data.DefaultStrategy, existingData.DefaultStrategy) {
+		err := r.updateDefaultStrategy(ctx, data.Project.ValueString(), data.Environment.ValueString(), *data.DefaultStrategy)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to update project environment "+data.ID.String(), err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Removing environment from project", map[string]interface{}{"id": data.ID.ValueString()})
+	deleteResp, err := r.providerData.Client.RemoveEnvironmentFromProjectWithResponse(ctx, data.Project.ValueString(), data.Environment.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete project environment "+data.ID.String(), err.Error())
+		return
+	}
+	if deleteResp.StatusCode() > 299 && deleteResp.StatusCode() != 404 {
+		resp.Diagnostics.AddError("failed to delete project environment "+data.ID.String(), fmt.Sprintf(" with status %d %s", deleteResp.StatusCode(), string(deleteResp.Body)))
+		return
+	}
+}
+
+// ImportState accepts `<project>.<environment>`. The environment name may contain dots but the project ID may not.
+func (r *ProjectEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, environmentID, found := strings.Cut(req.ID, ".")
+	if !found || projectID == "" || environmentID == "" {
+		resp.Diagnostics.AddError("Invalid Project Environment Import ID",
+			fmt.Sprintf("Expected <project>.<environment> but got %q", req.ID))
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,99 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestAccProjectEnvironmentResource(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_environment" "staging" {
+	name = "staging"
+	type = "test"
+}
+
+resource "unleash_project_environment" "default_staging" {
+	project = "default"
+	environment = unleash_environment.staging.name
+	default_strategy = {
+		name = "flexibleRollout"
+		disabled = false
+		title = "default rollout"
+		parameters = {
+			rollout = "50"
+			stickiness = "default"
+			groupId = ""
+		}
+		constraints = [
+			{
+				context_name = "userId"
+				operator = "IN"
+				values_json = jsonencode(["1", "2"])
+			}
+		]
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_project_environment.default_staging", "id", "default.staging"),
+					resource.TestCheckResourceAttr("unleash_project_environment.default_staging", "project", "default"),
+					resource.TestCheckResourceAttr("unleash_project_environment.default_staging", "environment", "staging"),
+					resource.TestCheckResourceAttr("unleash_project_environment.default_staging", "default_strategy.name", "flexibleRollout"),
+					resource.TestCheckResourceAttr("unleash_project_environment.default_staging", "default_strategy.title", "default rollout"),
+					resource.TestCheckResourceAttr("unleash_project_environment.default_staging", "default_strategy.parameters.rollout", "50"),
+					resource.TestCheckResourceAttr("unleash_project_environment.default_staging", "default_strategy.constraints.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unleash_project_environment.default_staging",
+				ImportStateId:     "default.staging",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "unleash_project_environment.default_staging",
+				ImportStateId: "staging",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile("Invalid Project Environment Import ID"),
+			},
+			//	Update and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_environment" "staging" {
+	name = "staging"
+	type = "test"
+}
+
+resource "unleash_project_environment" "default_staging" {
+	project = "default"
+	environment = unleash_environment.staging.name
+	default_strategy = {
+		name = "default"
+		disabled = true
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_project_environment.default_staging", "id", "default.staging"),
+					resource.TestCheckResourceAttr("unleash_project_environment.default_staging", "default_strategy.name", "default"),
+					resource.TestCheckResourceAttr("unleash_project_environment.default_staging", "default_strategy.disabled", "true"),
+					resource.TestCheckNoResourceAttr("unleash_project_environment.default_staging", "default_strategy.title"),
+					resource.TestCheckNoResourceAttr("unleash_project_environment.default_staging", "default_strategy.parameters"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+var _ resource.Resource = &ProjectResource{}
+var _ resource.ResourceWithImportState = &ProjectResource{}
+
+func NewProjectResource() resource.Resource {
+	return &ProjectResource{}
+}
+
+type ProjectResource struct {
+	providerData UnleashProviderData
+}
+
+type ProjectResourceModel struct {
+	ProjectModel
+}
+
+func (r *ProjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *ProjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Project resource",
+
+		Attributes: createProjectResourceSchemaAttr(),
+	}
+}
+
+func (r *ProjectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := unleash.CreateProjectJSONRequestBody{
+		Id:          data.ID.ValueString(),
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+	}
+	if !data.Mode.IsNull() && !data.Mode.IsUnknown() {
+		body.Mode = ptr.ToPtr(unleash.CreateProjectSchemaMode(data.Mode.ValueString()))
+	}
+	if !data.DefaultStickiness.IsNull() && !data.DefaultStickiness.IsUnknown() {
+		body.DefaultStickiness = data.DefaultStickiness.ValueStringPointer()
+	}
+	if data.Environments != nil {
+		environments := toStringValueSlice(data.Environments)
+		body.Environments = &environments
+	}
+
+	tflog.Debug(ctx, "Creating project", map[string]interface{}{"body": body})
+	createResp, err := r.providerData.Client.CreateProjectWithResponse(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create project "+data.ID.String(), err.Error())
+		return
+	}
+	if createResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to create project "+data.ID.String(), fmt.Sprintf(" with status %d %s", createResp.StatusCode(), string(createResp.Body)))
+		return
+	}
+	if createResp.JSON201.Mode != nil {
+		data.Mode = types.StringValue(string(*createResp.JSON201.Mode))
+	}
+	if createResp.JSON201.DefaultStickiness != nil {
+		data.DefaultStickiness = types.StringValue(*createResp.JSON201.DefaultStickiness)
+	}
+	if data.Mode.IsUnknown() {
+		data.Mode = types.StringValue(string(unleash.CreateProjectSchemaModeOpen))
+	}
+	if data.DefaultStickiness.IsUnknown() {
+		data.DefaultStickiness = types.StringValue("default")
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading project", map[string]interface{}{"id": data.ID.ValueString()})
+	readResp, err := r.providerData.Client.GetProjectOverviewWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get project", err.Error())
+		return
+	}
+	if readResp.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if readResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to read project "+data.ID.String(), fmt.Sprintf(" with status %d %s", readResp.StatusCode(), string(readResp.Body)))
+		return
+	}
+	projectModel := toProjectModel(data.ID.ValueString(), readResp.JSON200)
+	ensureProjectModelNullAndEmptyConsistency(&projectModel, data.ProjectModel)
+	data.ProjectModel = projectModel
+
+	tflog.Trace(ctx, "read resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectResourceModel
+	var existingData ProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &existingData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectBody := toProjectBody(data)
+	existingProjectBody := toProjectBody(existingData)
+	if !cmp.Equal(projectBody, existingProjectBody) {
+		tflog.Debug(ctx, "Updating project", map[string]interface{}{
+			"id":   data.ID.ValueString(),
+			"body": projectBody,
+		})
+		updateResp, err := r.providerData.Client.UpdateProjectWithResponse(ctx, data.ID.ValueString(), projectBody)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to update project "+data.ID.String(), err.Error())
+			return
+		}
+		if updateResp.StatusCode() > 299 {
+			resp.Diagnostics.AddError("failed to update project "+data.ID.String(), fmt.Sprintf(" with status %d %s", updateResp.StatusCode(), string(updateResp.Body)))
+			return
+		}
+	}
+
+	if data.Environments != nil {
+		err := r.updateEnvironments(ctx, data.ID.ValueString(), data.Environments, existingData.Environments)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to update project environments "+data.ID.String(), err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func toProjectBody(data ProjectResourceModel) unleash.UpdateProjectJSONRequestBody {
+	body := unleash.UpdateProjectJSONRequestBody{
+		Name:              data.Name.ValueString(),
+		Description:       data.Description.ValueStringPointer(),
+		DefaultStickiness: data.DefaultStickiness.ValueStringPointer(),
+	}
+	if body.Description == nil {
+		body.Description = ptr.ToPtr("")
+	}
+	if !data.Mode.IsNull() {
+		body.Mode = ptr.ToPtr(unleash.UpdateProjectSchemaMode(data.Mode.ValueString()))
+	}
+
+	return body
+}
+
+func (r *ProjectResource) updateEnvironments(ctx context.Context, projectID string, environments []types.String, existingEnvironments []types.String) error {
+	environmentSet := toStringSet(environments)
+	existingEnvironmentSet := toStringSet(existingEnvironments)
+
+	for environment := range environmentSet {
+		if _, ok := existingEnvironmentSet[environment]; ok {
+			continue
+		}
+		tflog.Debug(ctx, "Adding environment to project", map[string]interface{}{
+			"projectID":     projectID,
+			"environmentID": environment,
+		})
+		resp, err := r.providerData.Client.AddEnvironmentToProjectWithResponse(ctx, projectID, unleash.AddEnvironmentToProjectJSONRequestBody{
+			Environment: environment,
+		})
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode() > 299 {
+			return fmt.Errorf("failed to add environment %s to project %s with status %d %s", environment, projectID, resp.StatusCode(), string(resp.Body))
+		}
+	}
+	for environment := range existingEnvironmentSet {
+		if _, ok := environmentSet[environment]; ok {
+			continue
+		}
+		tflog.Debug(ctx, "Removing environment from project", map[string]interface{}{
+			"projectID":     projectID,
+			"environmentID": environment,
+		})
+		resp, err := r.providerData.Client.RemoveEnvironmentFromProjectWithResponse(ctx, projectID, environment)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode() > 299 {
+			return fmt.Errorf("failed to remove environment %s from project %s with status %d %s", environment, projectID, resp.StatusCode(), string(resp.Body))
+		}
+	}
+
+	return nil
+}
+
+func toStringValueSlice(values []types.String) []string {
+	stringValues := make([]string, 0, len(values))
+	for _, value := range values {
+		stringValues = append(stringValues, value.ValueString())
+	}
+
+	return stringValues
+}
+
+func toStringSet(values []types.String) map[string]struct{} {
+	stringSet := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		stringSet[value.ValueString()] = struct{}{}
+	}
+
+	return stringSet
+}
+
+func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting project", map[string]interface{}{"id": data.ID.ValueString()})
+	deleteResp, err := r.providerData.Client.DeleteProjectWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete project "+data.ID.String(), err.Error())
+		return
+	}
+	if deleteResp.StatusCode() > 299 && deleteResp.StatusCode() != 404 {
+		resp.Diagnostics.AddError("failed to delete project "+data.ID.String(), fmt.Sprintf(" with status %d %s", deleteResp.StatusCode(), string(deleteResp.Body)))
+		return
+	}
+}
+
+func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
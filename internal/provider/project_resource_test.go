@@ -0,0 +1,68 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestAccProjectResource(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_project" "project1" {
+	id = "project1"
+	name = "Project 1"
+	description = "desc project1"
+	environments = ["development"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_project.project1", "id", "project1"),
+					resource.TestCheckResourceAttr("unleash_project.project1", "name", "Project 1"),
+					resource.TestCheckResourceAttr("unleash_project.project1", "description", "desc project1"),
+					resource.TestCheckResourceAttr("unleash_project.project1", "mode", "open"),
+					resource.TestCheckResourceAttr("unleash_project.project1", "default_stickiness", "default"),
+					resource.TestCheckResourceAttr("unleash_project.project1", "environments.#", "1"),
+					resource.TestCheckTypeSetElemAttr("unleash_project.project1", "environments.*", "development"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unleash_project.project1",
+				ImportStateId:     "project1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			//	Update and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_project" "project1" {
+	id = "project1"
+	name = "Project 1 mod"
+	mode = "protected"
+	default_stickiness = "userId"
+	environments = ["production"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_project.project1", "id", "project1"),
+					resource.TestCheckResourceAttr("unleash_project.project1", "name", "Project 1 mod"),
+					resource.TestCheckNoResourceAttr("unleash_project.project1", "description"),
+					resource.TestCheckResourceAttr("unleash_project.project1", "mode", "protected"),
+					resource.TestCheckResourceAttr("unleash_project.project1", "default_stickiness", "userId"),
+					resource.TestCheckResourceAttr("unleash_project.project1", "environments.#", "1"),
+					resource.TestCheckTypeSetElemAttr("unleash_project.project1", "environments.*", "production"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -2,10 +2,18 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"os"
 	"regexp"
+	"strings"
+	"time"
+	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -30,14 +38,39 @@ type UnleashProvider struct {
 type UnleashProviderModel struct {
 	BaseURL                  types.String `tfsdk:"base_url"`
 	AuthorizationToken       types.String `tfsdk:"authorization"`
+	AuthorizationFile        types.String `tfsdk:"authorization_file"`
 	StrategyTitleIgnoreRegEx types.String `tfsdk:"strategy_title_ignore_regexp"`
+	FeatureDeleteMode        types.String `tfsdk:"feature_delete_mode"`
+	MaxRetries               types.Int64  `tfsdk:"max_retries"`
+	RetryMinBackoff          types.String `tfsdk:"retry_min_backoff"`
+	RetryMaxBackoff          types.String `tfsdk:"retry_max_backoff"`
+	Timeout                  types.String `tfsdk:"timeout"`
+	CACertFile               types.String `tfsdk:"ca_cert_file"`
+	ClientCertFile           types.String `tfsdk:"client_cert_file"`
+	ClientKeyFile            types.String `tfsdk:"client_key_file"`
+	InsecureSkipVerify       types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyURL                 types.String `tfsdk:"proxy_url"`
 }
 
 type UnleashProviderData struct {
 	Client                   unleash.ClientWithResponsesInterface
 	StrategyTitleIgnoreRegEx *regexp.Regexp
+	FeatureDeleteMode        string
 }
 
+const (
+	baseURLEnv                = "UNLEASH_BASE_URL"
+	authorizationTokenEnv     = "UNLEASH_AUTHORIZATION_TOKEN"
+	authorizationTokenFileEnv = "UNLEASH_AUTHORIZATION_TOKEN_FILE"
+)
+
+const (
+	// featureDeleteModeArchive only archives a destroyed feature so that its history is kept.
+	featureDeleteModeArchive = "archive"
+	// featureDeleteModeDelete archives a destroyed feature and then deletes it from the archive.
+	featureDeleteModeDelete = "delete"
+)
+
 func (p *UnleashProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "unleash"
 	resp.Version = p.version
@@ -47,18 +80,70 @@ func (p *UnleashProvider) Schema(ctx context.Context, req provider.SchemaRequest
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"base_url": schema.StringAttribute{
-				MarkdownDescription: "Unleash base URL (everything before `/api`)",
+				MarkdownDescription: "Unleash base URL (everything before `/api`). Defaults to `" + baseURLEnv + "` environment variable.",
 				Optional:            true,
 			},
 			"authorization": schema.StringAttribute{
-				MarkdownDescription: "Authorization token for Unleash API",
+				MarkdownDescription: "Authorization token for Unleash API. Conflicts with `authorization_file`. " +
+					"Defaults to `" + authorizationTokenEnv + "` environment variable or the content of the file at `" + authorizationTokenFileEnv + "` environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"authorization_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the authorization token for Unleash API, e.g. a mounted secret. Leading and trailing whitespaces are trimmed. Conflicts with `authorization`.",
 				Optional:            true,
-				Sensitive:           true,
 			},
 			"strategy_title_ignore_regexp": schema.StringAttribute{
 				MarkdownDescription: "Regular expression to ignore strategies by title. The matched strategies will not be managed by this provider.",
 				Optional:            true,
 			},
+			"feature_delete_mode": schema.StringAttribute{
+				MarkdownDescription: "How a feature is removed from Unleash when it is destroyed. " +
+					"`archive` only archives the feature so that its history is kept and it is revived when it is created again. " +
+					"`delete` archives the feature and then permanently deletes it from the archive, which is the only way Unleash allows a feature to be deleted. " +
+					"Defaults to `delete`.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries of a request which failed with a transient error. " +
+					"Requests rejected with `429 Too Many Requests` are always retried while requests failed with a network error, `502`, `503` or `504` are only retried if they are idempotent, " +
+					"e.g. a request which adds a strategy is not retried as it may have already been added. Set to `0` to disable retries. Defaults to `3`.",
+				Optional: true,
+			},
+			"retry_min_backoff": schema.StringAttribute{
+				MarkdownDescription: "Wait time before the first retry, e.g. `500ms`. The wait time is doubled with jitter for each following retry. " +
+					"`Retry-After` header of the response takes precedence. Defaults to `500ms`.",
+				Optional: true,
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				MarkdownDescription: "Maximum wait time between retries, e.g. `30s`. Defaults to `30s`.",
+				Optional:            true,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout of a request to Unleash API including its retries, e.g. `2m`. Defaults to `60s`.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to PEM encoded CA certificates which are trusted in addition to the system ones, e.g. for Unleash behind a private CA.",
+				Optional:            true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to PEM encoded client certificate for mutual TLS. `client_key_file` is required as well.",
+				Optional:            true,
+			},
+			"client_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to PEM encoded private key of `client_cert_file`.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verification of the server certificate. This should only be used for testing.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy which requests are sent through, e.g. `http://proxy.example.com:3128`. " +
+					"Defaults to the proxy from `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -72,11 +157,16 @@ func (p *UnleashProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	// Configuration values are now available.
-	// if data.Endpoint.IsNull() { /* ... */ }
+	baseURL := resolveBaseURL(data, &resp.Diagnostics)
+	authorizationToken := resolveAuthorizationToken(data, &resp.Diagnostics)
+	clientOptions := toClientOptions(data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var providerData UnleashProviderData
 	var err error
-	providerData.Client, err = unleash.CreateClient(data.BaseURL.ValueString(), data.AuthorizationToken.ValueString())
+	providerData.Client, err = unleash.CreateClient(baseURL, authorizationToken, clientOptions...)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create unleash", err.Error())
 		return
@@ -89,19 +179,216 @@ func (p *UnleashProvider) Configure(ctx context.Context, req provider.ConfigureR
 		}
 	}
 
+	switch data.FeatureDeleteMode.ValueString() {
+	case "", featureDeleteModeDelete:
+		providerData.FeatureDeleteMode = featureDeleteModeDelete
+	case featureDeleteModeArchive:
+		providerData.FeatureDeleteMode = featureDeleteModeArchive
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("feature_delete_mode"), "invalid feature delete mode",
+			fmt.Sprintf("expected %q or %q but got %q", featureDeleteModeArchive, featureDeleteModeDelete, data.FeatureDeleteMode.ValueString()))
+	}
+
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
 }
 
+// resolveBaseURL returns base_url or falls back to UNLEASH_BASE_URL environment variable.
+func resolveBaseURL(data UnleashProviderModel, diags *diag.Diagnostics) string {
+	if data.BaseURL.IsUnknown() {
+		diags.AddAttributeError(path.Root("base_url"), "unknown Unleash base URL",
+			"base_url must be known when the provider is configured. Use a static value or "+baseURLEnv+" environment variable.")
+		return ""
+	}
+	baseURL := data.BaseURL.ValueString()
+	source := "base_url"
+	if data.BaseURL.IsNull() {
+		baseURL = os.Getenv(baseURLEnv)
+		source = baseURLEnv + " environment variable"
+	}
+	if baseURL == "" {
+		diags.AddAttributeError(path.Root("base_url"), "missing Unleash base URL",
+			"set base_url in the provider configuration or "+baseURLEnv+" environment variable, e.g. https://unleash.example.com")
+		return ""
+	}
+
+	parsed, err := url.Parse(baseURL)
+	if err != nil {
+		diags.AddAttributeError(path.Root("base_url"), "invalid Unleash base URL", fmt.Sprintf("%s is not a valid URL: %s", source, err))
+		return ""
+	}
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		diags.AddAttributeError(path.Root("base_url"), "invalid Unleash base URL",
+			fmt.Sprintf("%s must be an absolute http or https URL, e.g. https://unleash.example.com, but got %q", source, baseURL))
+		return ""
+	}
+	if strings.HasSuffix(strings.TrimSuffix(parsed.Path, "/"), "/api") {
+		diags.AddAttributeError(path.Root("base_url"), "invalid Unleash base URL",
+			fmt.Sprintf("%s must not include /api but got %q", source, baseURL))
+		return ""
+	}
+
+	return baseURL
+}
+
+// resolveAuthorizationToken returns authorization or the content of authorization_file.
+// It falls back to UNLEASH_AUTHORIZATION_TOKEN or UNLEASH_AUTHORIZATION_TOKEN_FILE environment variables if neither is set.
+func resolveAuthorizationToken(data UnleashProviderModel, diags *diag.Diagnostics) string {
+	if data.AuthorizationToken.IsUnknown() || data.AuthorizationFile.IsUnknown() {
+		diags.AddAttributeError(path.Root("authorization"), "unknown Unleash authorization token",
+			"authorization and authorization_file must be known when the provider is configured. Use a static value or "+authorizationTokenEnv+" environment variable.")
+		return ""
+	}
+	if !data.AuthorizationToken.IsNull() && !data.AuthorizationFile.IsNull() {
+		diags.AddAttributeError(path.Root("authorization_file"), "conflicting Unleash authorization token",
+			"only one of authorization and authorization_file can be set")
+		return ""
+	}
+
+	var token, source string
+	switch {
+	case !data.AuthorizationToken.IsNull():
+		token, source = data.AuthorizationToken.ValueString(), "authorization"
+	case !data.AuthorizationFile.IsNull():
+		token, source = readAuthorizationFile(data.AuthorizationFile.ValueString(), "authorization_file", diags)
+	case os.Getenv(authorizationTokenEnv) != "":
+		token, source = os.Getenv(authorizationTokenEnv), authorizationTokenEnv+" environment variable"
+	case os.Getenv(authorizationTokenFileEnv) != "":
+		token, source = readAuthorizationFile(os.Getenv(authorizationTokenFileEnv), authorizationTokenFileEnv+" environment variable", diags)
+	}
+	if diags.HasError() {
+		return ""
+	}
+	if token == "" {
+		diags.AddAttributeError(path.Root("authorization"), "missing Unleash authorization token",
+			"set authorization or authorization_file in the provider configuration, or "+authorizationTokenEnv+" or "+authorizationTokenFileEnv+" environment variable")
+		return ""
+	}
+	if strings.ContainsFunc(token, func(r rune) bool { return unicode.IsSpace(r) || unicode.IsControl(r) }) {
+		diags.AddAttributeError(path.Root("authorization"), "invalid Unleash authorization token",
+			fmt.Sprintf("the token from %s must not contain whitespaces or control characters", source))
+		return ""
+	}
+
+	return token
+}
+
+func readAuthorizationFile(fileName string, source string, diags *diag.Diagnostics) (string, string) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		diags.AddAttributeError(path.Root("authorization_file"), "failed to read Unleash authorization token",
+			fmt.Sprintf("failed to read the file from %s: %s", source, err))
+		return "", source
+	}
+
+	return strings.TrimSpace(string(content)), "file " + fileName
+}
+
+func toClientOptions(data UnleashProviderModel, diags *diag.Diagnostics) []unleash.CreateClientOption {
+	options := []unleash.CreateClientOption{
+		unleash.WithRetry(toRetryConfig(data, diags)),
+		unleash.WithTLS(unleash.TLSConfig{
+			CACertFile:         data.CACertFile.ValueString(),
+			ClientCertFile:     data.ClientCertFile.ValueString(),
+			ClientKeyFile:      data.ClientKeyFile.ValueString(),
+			InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+		}),
+	}
+	if data.ClientCertFile.IsNull() != data.ClientKeyFile.IsNull() {
+		diags.AddAttributeError(path.Root("client_cert_file"), "invalid client certificate",
+			"both client_cert_file and client_key_file are required for mutual TLS")
+	}
+
+	if !data.Timeout.IsNull() {
+		timeout, err := time.ParseDuration(data.Timeout.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("timeout"), "invalid timeout", err.Error())
+		} else if timeout <= 0 {
+			diags.AddAttributeError(path.Root("timeout"), "invalid timeout",
+				fmt.Sprintf("expected a positive duration but got %s", timeout))
+		}
+		options = append(options, unleash.WithTimeout(timeout))
+	}
+
+	if !data.ProxyURL.IsNull() {
+		proxyURL, err := url.Parse(data.ProxyURL.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("proxy_url"), "invalid proxy URL", err.Error())
+		} else if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			diags.AddAttributeError(path.Root("proxy_url"), "invalid proxy URL",
+				fmt.Sprintf("expected an absolute URL, e.g. http://proxy.example.com:3128, but got %q", data.ProxyURL.ValueString()))
+		}
+		options = append(options, unleash.WithProxy(proxyURL))
+	}
+
+	return options
+}
+
+func toRetryConfig(data UnleashProviderModel, diags *diag.Diagnostics) unleash.RetryConfig {
+	retry := unleash.DefaultRetryConfig()
+	if !data.MaxRetries.IsNull() {
+		if data.MaxRetries.ValueInt64() < 0 {
+			diags.AddAttributeError(path.Root("max_retries"), "invalid max retries",
+				fmt.Sprintf("expected a non-negative number but got %d", data.MaxRetries.ValueInt64()))
+		}
+		retry.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+	retry.MinBackoff = parseBackoff(data.RetryMinBackoff, path.Root("retry_min_backoff"), retry.MinBackoff, diags)
+	retry.MaxBackoff = parseBackoff(data.RetryMaxBackoff, path.Root("retry_max_backoff"), retry.MaxBackoff, diags)
+	if !diags.HasError() && retry.MinBackoff > retry.MaxBackoff {
+		diags.AddAttributeError(path.Root("retry_min_backoff"), "invalid retry backoff",
+			fmt.Sprintf("retry_min_backoff %s must not be greater than retry_max_backoff %s", retry.MinBackoff, retry.MaxBackoff))
+	}
+
+	return retry
+}
+
+func parseBackoff(value types.String, attributePath path.Path, defaultValue time.Duration, diags *diag.Diagnostics) time.Duration {
+	if value.IsNull() {
+		return defaultValue
+	}
+	backoff, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(attributePath, "invalid retry backoff", err.Error())
+		return defaultValue
+	}
+	if backoff < 0 {
+		diags.AddAttributeError(attributePath, "invalid retry backoff",
+			fmt.Sprintf("expected a non-negative duration but got %s", backoff))
+	}
+
+	return backoff
+}
+
 func (p *UnleashProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewFeatureResource,
 		NewSegmentResource,
+		NewProjectResource,
+		NewContextFieldResource,
+		NewEnvironmentResource,
+		NewProjectEnvironmentResource,
+		NewApiTokenResource,
+		NewTagTypeResource,
+		NewStrategyResource,
+		NewAddonResource,
+		NewUserResource,
+		NewPersonalAccessTokenResource,
+		NewPublicSignupTokenResource,
+		NewFeatureStrategyResource,
+		NewFeatureEnvironmentResource,
 	}
 }
 
 func (p *UnleashProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewFeatureDataSource,
+		NewFeaturesDataSource,
+		NewSegmentDataSource,
+		NewSegmentsDataSource,
+		NewProjectDataSource,
+		NewEnvironmentsDataSource,
+	}
 }
 
 func (p *UnleashProvider) Functions(_ context.Context) []func() function.Function {
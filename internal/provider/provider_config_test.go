@@ -0,0 +1,87 @@
+package provider_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+const testProviderConfigProjectConf = `
+data "unleash_project" "default" {
+	id = "default"
+}
+`
+
+func TestAccProviderConfigEnv(t *testing.T) {
+	port := inmem.CreateTestServer().Start(t)
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("*:development.x\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("UNLEASH_BASE_URL", fmt.Sprintf("http://localhost:%d", port))
+	t.Setenv("UNLEASH_AUTHORIZATION_TOKEN", "")
+	t.Setenv("UNLEASH_AUTHORIZATION_TOKEN_FILE", tokenFile)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Environment variables testing
+			{
+				Config: `provider "unleash" {}` + testProviderConfigProjectConf,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unleash_project.default", "name", "Default"),
+				),
+			},
+			// Token file testing
+			{
+				Config: fmt.Sprintf(`
+	provider "unleash" {
+		authorization_file = %q
+	}
+	`, tokenFile) + testProviderConfigProjectConf,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unleash_project.default", "name", "Default"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigInvalid(t *testing.T) {
+	t.Setenv("UNLEASH_BASE_URL", "")
+	t.Setenv("UNLEASH_AUTHORIZATION_TOKEN", "")
+	t.Setenv("UNLEASH_AUTHORIZATION_TOKEN_FILE", "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      `provider "unleash" {}` + testProviderConfigProjectConf,
+				ExpectError: regexp.MustCompile("missing Unleash base URL"),
+			},
+			{
+				Config: `
+	provider "unleash" {
+		base_url = "localhost:4242"
+		authorization = "*:development.x"
+	}
+	` + testProviderConfigProjectConf,
+				ExpectError: regexp.MustCompile("invalid Unleash base URL"),
+			},
+			{
+				Config: `
+	provider "unleash" {
+		base_url = "http://localhost:4242"
+	}
+	` + testProviderConfigProjectConf,
+				ExpectError: regexp.MustCompile("missing Unleash authorization token"),
+			},
+		},
+	})
+}
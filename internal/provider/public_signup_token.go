@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+type PublicSignupTokenModel struct {
+	Secret    types.String `tfsdk:"secret"`
+	Name      types.String `tfsdk:"name"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	URL       types.String `tfsdk:"url"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func createPublicSignupTokenResourceSchemaAttr() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"secret": schema.StringAttribute{
+			Description: "The generated token which identifies this invite link",
+			Computed:    true,
+			Sensitive:   true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of this token which is only displayed in the UI. Changing it creates a new token.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"expires_at": schema.StringAttribute{
+			Description: "The time when this token expires in RFC 3339 format e.g. 2030-01-02T15:04:05Z",
+			Required:    true,
+		},
+		"enabled": schema.BoolAttribute{
+			Description: "Whether users can sign up with this token. A new token is enabled unless specified otherwise",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"url": schema.StringAttribute{
+			Description: "The invite link which users follow to sign up",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"created_at": schema.StringAttribute{
+			Description: "The time when this token was created",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func toPublicSignupTokenModel(token unleash.PublicSignupTokenSchema) PublicSignupTokenModel {
+	tokenModel := PublicSignupTokenModel{
+		Secret:    types.StringValue(token.Secret),
+		Name:      types.StringValue(token.Name),
+		ExpiresAt: types.StringValue(token.ExpiresAt.Format(time.RFC3339)),
+		Enabled:   types.BoolValue(token.Enabled),
+		CreatedAt: types.StringValue(token.CreatedAt.Format(time.RFC3339)),
+	}
+	if token.Url != nil {
+		tokenModel.URL = types.StringValue(*token.Url)
+	}
+
+	return tokenModel
+}
@@ -0,0 +1,9 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+func ensurePublicSignupTokenModelNullAndEmptyConsistency(tokenModel *PublicSignupTokenModel, tokenModelBefore PublicSignupTokenModel) {
+	tryKeepSameInstant(tokenModel.ExpiresAt, tokenModelBefore.ExpiresAt, func(value types.String) {
+		tokenModel.ExpiresAt = value
+	})
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+var _ resource.Resource = &PublicSignupTokenResource{}
+var _ resource.ResourceWithImportState = &PublicSignupTokenResource{}
+
+func NewPublicSignupTokenResource() resource.Resource {
+	return &PublicSignupTokenResource{}
+}
+
+type PublicSignupTokenResource struct {
+	providerData UnleashProviderData
+}
+
+type PublicSignupTokenResourceModel struct {
+	PublicSignupTokenModel
+}
+
+func (r *PublicSignupTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_public_signup_token"
+}
+
+func (r *PublicSignupTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Public signup token resource, which is an invite link to sign up as a Viewer. " +
+			"Unleash cannot delete a token so destroying it disables the token instead. A token is imported by its secret.",
+
+		Attributes: createPublicSignupTokenResourceSchemaAttr(),
+	}
+}
+
+func (r *PublicSignupTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *PublicSignupTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PublicSignupTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, data.ExpiresAt.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create public signup token "+data.Name.String(), fmt.Sprintf("invalid expires_at %s: %s", data.ExpiresAt.ValueString(), err.Error()))
+		return
+	}
+	body := unleash.CreatePublicSignupTokenJSONRequestBody{
+		Name:      data.Name.ValueString(),
+		ExpiresAt: expiresAt,
+	}
+	tflog.Debug(ctx, "Creating public signup token", map[string]interface{}{"body": body})
+	createResp, err := r.providerData.Client.CreatePublicSignupTokenWithResponse(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create public signup token "+data.Name.String(), err.Error())
+		return
+	}
+	if createResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to create public signup token "+data.Name.String(), fmt.Sprintf(" with status %d %s", createResp.StatusCode(), string(createResp.Body)))
+		return
+	}
+	token := *createResp.JSON201
+
+	// a new token is always enabled
+	if !data.Enabled.IsUnknown() && !data.Enabled.IsNull() && !data.Enabled.ValueBool() {
+		updatedToken, err := r.update(ctx, data.Name.ValueString(), token.Secret, unleash.UpdatePublicSignupTokenJSONRequestBody{
+			Enabled: ptr.ToPtr(false),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("failed to disable public signup token "+data.Name.String(), err.Error())
+			return
+		}
+		token = updatedToken
+	}
+	tokenModel := toPublicSignupTokenModel(token)
+	ensurePublicSignupTokenModelNullAndEmptyConsistency(&tokenModel, data.PublicSignupTokenModel)
+	data.PublicSignupTokenModel = tokenModel
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PublicSignupTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PublicSignupTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading public signup token", map[string]interface{}{"name": data.Name.ValueString()})
+	readResp, err := r.providerData.Client.GetPublicSignupTokenWithResponse(ctx, data.Secret.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read public signup token "+data.Name.String(), err.Error())
+		return
+	}
+	if readResp.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if readResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to read public signup token "+data.Name.String(), fmt.Sprintf(" with status %d %s", readResp.StatusCode(), string(readResp.Body)))
+		return
+	}
+	tokenModel := toPublicSignupTokenModel(*readResp.JSON200)
+	ensurePublicSignupTokenModelNullAndEmptyConsistency(&tokenModel, data.PublicSignupTokenModel)
+	data.PublicSignupTokenModel = tokenModel
+
+	tflog.Trace(ctx, "read resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PublicSignupTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PublicSignupTokenResourceModel
+	var existingData PublicSignupTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &existingData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// expires_at and enabled are the only attributes which can be updated, others require replacement
+	expiresAt, err := time.Parse(time.RFC3339, data.ExpiresAt.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update public signup token "+data.Name.String(), fmt.Sprintf("invalid expires_at %s: %s", data.ExpiresAt.ValueString(), err.Error()))
+		return
+	}
+	body := unleash.UpdatePublicSignupTokenJSONRequestBody{
+		ExpiresAt: &expiresAt,
+	}
+	if !data.Enabled.IsUnknown() && !data.Enabled.IsNull() {
+		body.Enabled = ptr.ToPtr(data.Enabled.ValueBool())
+	}
+	token, err := r.update(ctx, data.Name.ValueString(), existingData.Secret.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update public signup token "+data.Name.String(), err.Error())
+		return
+	}
+	tokenModel := toPublicSignupTokenModel(token)
+	ensurePublicSignupTokenModelNullAndEmptyConsistency(&tokenModel, data.PublicSignupTokenModel)
+	data.PublicSignupTokenModel = tokenModel
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PublicSignupTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PublicSignupTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unleash has no API to delete a token, so it is disabled as the UI does
+	tflog.Debug(ctx, "Disabling public signup token", map[string]interface{}{"name": data.Name.ValueString()})
+	_, err := r.update(ctx, data.Name.ValueString(), data.Secret.ValueString(), unleash.UpdatePublicSignupTokenJSONRequestBody{
+		Enabled: ptr.ToPtr(false),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete public signup token "+data.Name.String(), err.Error())
+		return
+	}
+}
+
+func (r *PublicSignupTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("secret"), req, resp)
+}
+
+func (r *PublicSignupTokenResource) update(ctx context.Context, name string, secret string, body unleash.UpdatePublicSignupTokenJSONRequestBody) (unleash.PublicSignupTokenSchema, error) {
+	tflog.Debug(ctx, "Updating public signup token", map[string]interface{}{
+		"name": name,
+		"body": body,
+	})
+	updateResp, err := r.providerData.Client.UpdatePublicSignupTokenWithResponse(ctx, secret, body)
+	if err != nil {
+		return unleash.PublicSignupTokenSchema{}, err
+	}
+	if updateResp.StatusCode() > 299 {
+		return unleash.PublicSignupTokenSchema{}, fmt.Errorf("failed to update public signup token %s with status %d %s", name, updateResp.StatusCode(), string(updateResp.Body))
+	}
+
+	return *updateResp.JSON200, nil
+}
@@ -0,0 +1,62 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestAccPublicSignupTokenResource(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_public_signup_token" "invite" {
+	name = "Invite"
+	expires_at = "2030-01-01T00:00:00Z"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unleash_public_signup_token.invite", "secret"),
+					resource.TestCheckResourceAttr("unleash_public_signup_token.invite", "name", "Invite"),
+					resource.TestCheckResourceAttr("unleash_public_signup_token.invite", "expires_at", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("unleash_public_signup_token.invite", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("unleash_public_signup_token.invite", "url"),
+					resource.TestCheckResourceAttrSet("unleash_public_signup_token.invite", "created_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "unleash_public_signup_token.invite",
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["unleash_public_signup_token.invite"].Primary.Attributes["secret"], nil
+				},
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "secret",
+			},
+			//	Update and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_public_signup_token" "invite" {
+	name = "Invite"
+	expires_at = "2031-01-01T00:00:00Z"
+	enabled = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_public_signup_token.invite", "expires_at", "2031-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("unleash_public_signup_token.invite", "enabled", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
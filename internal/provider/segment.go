@@ -12,6 +12,9 @@ import (
 	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
 )
 
+// segmentImportNamePrefix prefixes the name of a segment to import it by name rather than ID.
+const segmentImportNamePrefix = "name:"
+
 type SegmentModel struct {
 	ID          types.String      `tfsdk:"id"`
 	IDInt       types.Int64       `tfsdk:"id_int"`
@@ -37,8 +40,13 @@ func createSegmentResourceSchemaAttr() map[string]schema.Attribute {
 				int64planmodifier.UseStateForUnknown(),
 			},
 		},
+		"force_detach": schema.BoolAttribute{
+			Description: "Remove this segment from the strategies which still use it before deleting it. Otherwise deleting a segment in use fails. " +
+				"Deletion reads this value from the state, so `force_detach = true` must be applied before the segment is removed from the configuration",
+			Optional: true,
+		},
 		"project": schema.StringAttribute{
-			Description: "The name of project this segment belongs to",
+			Description: "The name of project this segment belongs to. Only features of the project can use a project segment while a segment without project is global",
 			Optional:    true,
 		},
 		"name": schema.StringAttribute{
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+var _ datasource.DataSource = &SegmentDataSource{}
+
+func NewSegmentDataSource() datasource.DataSource {
+	return &SegmentDataSource{}
+}
+
+type SegmentDataSource struct {
+	providerData UnleashProviderData
+}
+
+type SegmentDataSourceModel struct {
+	SegmentModel
+}
+
+func (d *SegmentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_segment"
+}
+
+func (d *SegmentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := createSegmentDataSourceSchemaAttr()
+	attrs["id"] = schema.StringAttribute{
+		Description: "ID of the segment. Either id or name must be specified.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attrs["name"] = schema.StringAttribute{
+		Description: "The name of the segment. Either id or name must be specified.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attrs["project"] = schema.StringAttribute{
+		Description: "The name of project the segment belongs to. This can be used to look up a segment by name when the name is used in multiple projects.",
+		Optional:    true,
+		Computed:    true,
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Segment data source",
+
+		Attributes: attrs,
+	}
+}
+
+func createSegmentDataSourceSchemaAttr() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "ID of this segment",
+			Computed:    true,
+		},
+		"id_int": schema.Int64Attribute{
+			Description: "ID of this segment as int",
+			Computed:    true,
+		},
+		"project": schema.StringAttribute{
+			Description: "The name of project this segment belongs to",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of this segment",
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "A description of what the segment is for",
+			Computed:    true,
+		},
+		"constraints": schema.ListNestedAttribute{
+			Description: "The list of constraints that make up this segment",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: createConstraintDataSourceSchemaAttrs(),
+			},
+		},
+	}
+}
+
+func createConstraintDataSourceSchemaAttrs() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"context_name": schema.StringAttribute{
+			Description: "Context name",
+			Computed:    true,
+		},
+		"case_insensitive": schema.BoolAttribute{
+			Description: "Case insensitive flag",
+			Computed:    true,
+		},
+		"operator": schema.StringAttribute{
+			Description: "Operator",
+			Computed:    true,
+		},
+		"inverted": schema.BoolAttribute{
+			Description: "Inverted flag",
+			Computed:    true,
+		},
+		"value": schema.StringAttribute{
+			Description: "The context value that should be used for constraint evaluation",
+			Computed:    true,
+		},
+		"values_json": schema.StringAttribute{
+			Description: "An array of string values encoded in JSON",
+			Computed:    true,
+		},
+	}
+}
+
+func (d *SegmentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *SegmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SegmentDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var segment *unleash.AdminSegmentSchema
+	var err error
+	if !data.ID.IsNull() {
+		segment, err = d.getSegmentByID(ctx, data.ID.ValueString())
+	} else if !data.Name.IsNull() {
+		segment, err = findSegmentByName(ctx, d.providerData.Client, data.Name.ValueString(), data.Project.ValueString())
+	} else {
+		err = fmt.Errorf("either id or name must be specified")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get segment", err.Error())
+		return
+	}
+	data.SegmentModel, err = toSegmentModel(segment)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to convert segment", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "read data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *SegmentDataSource) getSegmentByID(ctx context.Context, id string) (*unleash.AdminSegmentSchema, error) {
+	tflog.Debug(ctx, "Reading segment", map[string]interface{}{"id": id})
+	readResp, err := d.providerData.Client.GetSegmentWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if readResp.StatusCode() == 404 {
+		return nil, fmt.Errorf("segment %s is not found", id)
+	}
+	if readResp.StatusCode() > 299 {
+		return nil, fmt.Errorf("failed to read segment %s with status %d %s", id, readResp.StatusCode(), string(readResp.Body))
+	}
+
+	return readResp.JSON200, nil
+}
+
+// findSegmentByName returns the only segment with the name in the project or in any project if projectID is empty.
+func findSegmentByName(ctx context.Context, client unleash.ClientWithResponsesInterface, name string, projectID string) (*unleash.AdminSegmentSchema, error) {
+	segments, err := getSegments(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	var matched []unleash.AdminSegmentSchema
+	for _, segment := range segments {
+		if segment.Name != name {
+			continue
+		}
+		if projectID != "" && (segment.Project == nil || *segment.Project != projectID) {
+			continue
+		}
+		matched = append(matched, segment)
+	}
+	if len(matched) == 0 {
+		return nil, fmt.Errorf("segment %s is not found", name)
+	}
+	if len(matched) > 1 {
+		return nil, fmt.Errorf("found %d segments named %s, specify project or id to choose one", len(matched), name)
+	}
+
+	return &matched[0], nil
+}
+
+func getSegments(ctx context.Context, client unleash.ClientWithResponsesInterface) ([]unleash.AdminSegmentSchema, error) {
+	tflog.Debug(ctx, "Reading segments")
+	segmentsResp, err := client.GetSegmentsWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if segmentsResp.StatusCode() > 299 {
+		return nil, fmt.Errorf("failed to get segments with status %d %s", segmentsResp.StatusCode(), string(segmentsResp.Body))
+	}
+	if segmentsResp.JSON200.Segments == nil {
+		return nil, nil
+	}
+
+	return *segmentsResp.JSON200.Segments, nil
+}
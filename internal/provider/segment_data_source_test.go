@@ -0,0 +1,65 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestAccSegmentDataSource(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConf + `
+resource "unleash_segment" "global" {
+	name = "beta-users"
+	constraints = [{
+		context_name = "userId"
+		operator = "IN"
+		values_json = jsonencode(["1", "2"])
+	}]
+}
+
+resource "unleash_segment" "project" {
+	name = "beta-users"
+	project = "default"
+}
+
+data "unleash_segment" "by_id" {
+	id = unleash_segment.global.id
+}
+
+data "unleash_segment" "by_name" {
+	name = unleash_segment.project.name
+	project = "default"
+}
+
+data "unleash_segments" "all" {
+	depends_on = [unleash_segment.global, unleash_segment.project]
+}
+
+data "unleash_segments" "project" {
+	project = "default"
+	depends_on = [unleash_segment.global, unleash_segment.project]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.unleash_segment.by_id", "id_int", "unleash_segment.global", "id_int"),
+					resource.TestCheckResourceAttr("data.unleash_segment.by_id", "name", "beta-users"),
+					resource.TestCheckNoResourceAttr("data.unleash_segment.by_id", "project"),
+					resource.TestCheckResourceAttr("data.unleash_segment.by_id", "constraints.#", "1"),
+					resource.TestCheckResourceAttr("data.unleash_segment.by_id", "constraints.0.context_name", "userId"),
+					resource.TestCheckResourceAttrPair("data.unleash_segment.by_name", "id", "unleash_segment.project", "id"),
+					resource.TestCheckResourceAttr("data.unleash_segments.all", "segments.#", "2"),
+					resource.TestCheckResourceAttr("data.unleash_segments.project", "segments.#", "1"),
+					resource.TestCheckResourceAttrPair("data.unleash_segments.project", "segments.0.id", "unleash_segment.project", "id"),
+				),
+			},
+		},
+	})
+}
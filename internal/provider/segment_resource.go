@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -27,6 +29,7 @@ type SegmentResource struct {
 
 type SegmentResourceModel struct {
 	SegmentModel
+	ForceDetach types.Bool `tfsdk:"force_detach"`
 }
 
 func (r *SegmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -35,7 +38,7 @@ func (r *SegmentResource) Metadata(_ context.Context, req resource.MetadataReque
 
 func (r *SegmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Segment resource",
+		MarkdownDescription: "Segment resource. It can be imported by its ID, `name:<name>` or `<project>/<name>`.",
 
 		Attributes: createSegmentResourceSchemaAttr(),
 	}
@@ -207,6 +210,41 @@ func (r *SegmentResource) Delete(ctx context.Context, req resource.DeleteRequest
 		"id":        data.ID.ValueString(),
 		"name":      data.Name.ValueString(),
 	})
+	segmentStrategies, err := r.getStrategies(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get strategies of segment "+data.ID.String(), err.Error())
+		return
+	}
+	strategies := segmentStrategies.Strategies
+	if len(strategies) > 0 {
+		if !data.ForceDetach.ValueBool() {
+			usages := make([]string, 0, len(strategies))
+			for _, strategy := range strategies {
+				usages = append(usages, fmt.Sprintf("  - %s strategy %s of feature %s in project %s environment %s",
+					strategy.StrategyName, strategy.Id, strategy.FeatureName, strategy.ProjectId, strategy.Environment))
+			}
+			resp.Diagnostics.AddError("Segment Is In Use",
+				fmt.Sprintf("Segment %s is still used by the following strategies:\n%s\n\n"+
+					"Remove the segment from the strategies or set force_detach = true to remove it from them before deletion.",
+					data.Name.ValueString(), strings.Join(usages, "\n")))
+			return
+		}
+		for _, strategy := range strategies {
+			tflog.Debug(ctx, "Detaching segment", map[string]interface{}{
+				"id":          data.ID.ValueString(),
+				"projectID":   strategy.ProjectId,
+				"featureName": strategy.FeatureName,
+				"environment": strategy.Environment,
+				"strategyID":  strategy.Id,
+			})
+			err := r.detachSegment(ctx, data.IDInt.ValueInt64(), strategy.ProjectId, strategy.FeatureName, strategy.Environment, strategy.Id)
+			if err != nil {
+				resp.Diagnostics.AddError("failed to detach segment "+data.ID.String(), err.Error())
+				return
+			}
+		}
+	}
+
 	removeResp, err := r.providerData.Client.RemoveSegmentWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("failed to delete segment "+data.ID.String(), err.Error())
@@ -218,6 +256,92 @@ func (r *SegmentResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+func (r *SegmentResource) getStrategies(ctx context.Context, id string) (unleash.SegmentStrategiesSchema, error) {
+	strategiesResp, err := r.providerData.Client.GetStrategiesBySegmentIdWithResponse(ctx, id)
+	if err != nil {
+		return unleash.SegmentStrategiesSchema{}, err
+	}
+	if strategiesResp.StatusCode() > 299 {
+		return unleash.SegmentStrategiesSchema{}, fmt.Errorf("status %d %s", strategiesResp.StatusCode(), string(strategiesResp.Body))
+	}
+
+	return *strategiesResp.JSON200, nil
+}
+
+// detachSegment removes the segment from the strategy while keeping the other segments.
+func (r *SegmentResource) detachSegment(ctx context.Context, id int64, projectID string, featureName string, environmentID string, strategyID string) error {
+	strategiesResp, err := r.providerData.Client.GetFeatureStrategiesWithResponse(ctx, projectID, featureName, environmentID)
+	if err != nil {
+		return err
+	}
+	if strategiesResp.StatusCode() == 404 {
+		return nil
+	}
+	if strategiesResp.StatusCode() > 299 {
+		return fmt.Errorf("failed to get strategies of feature %s in environment %s with status %d %s",
+			featureName, environmentID, strategiesResp.StatusCode(), string(strategiesResp.Body))
+	}
+	body := unleash.UpdateFeatureStrategySegmentsJSONRequestBody{
+		ProjectId:     projectID,
+		StrategyId:    strategyID,
+		EnvironmentId: environmentID,
+		SegmentIds:    []int{},
+	}
+	found := false
+	for _, featureStrategy := range *strategiesResp.JSON200 {
+		if featureStrategy.Id == nil || *featureStrategy.Id != strategyID {
+			continue
+		}
+		found = true
+		if featureStrategy.Segments == nil {
+			continue
+		}
+		for _, segmentID := range *featureStrategy.Segments {
+			if int64(segmentID) != id {
+				body.SegmentIds = append(body.SegmentIds, int(segmentID))
+			}
+		}
+	}
+	// the strategy has been deleted since the usages were fetched
+	if !found {
+		return nil
+	}
+
+	updateResp, err := r.providerData.Client.UpdateFeatureStrategySegmentsWithResponse(ctx, body)
+	if err != nil {
+		return err
+	}
+	if updateResp.StatusCode() > 299 {
+		return fmt.Errorf("failed to update segments of strategy %s of feature %s in environment %s with status %d %s",
+			strategyID, featureName, environmentID, updateResp.StatusCode(), string(updateResp.Body))
+	}
+
+	return nil
+}
+
+// ImportState accepts the segment ID, `name:<name>` or `<project>/<name>`.
 func (r *SegmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if _, err := strconv.Atoi(req.ID); err == nil {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	var name, projectID string
+	if strings.HasPrefix(req.ID, segmentImportNamePrefix) {
+		name = strings.TrimPrefix(req.ID, segmentImportNamePrefix)
+	} else if before, after, found := strings.Cut(req.ID, "/"); found && before != "" {
+		projectID, name = before, after
+	}
+	if name == "" {
+		resp.Diagnostics.AddError("Invalid Segment Import ID",
+			fmt.Sprintf("Expected a segment ID, %s<name> or <project>/<name> but got %q", segmentImportNamePrefix, req.ID))
+		return
+	}
+	segment, err := findSegmentByName(ctx, r.providerData.Client, name, projectID)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to import segment "+req.ID, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.Itoa(segment.Id))...)
 }
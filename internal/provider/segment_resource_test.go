@@ -1,6 +1,7 @@
 package provider_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -63,6 +64,40 @@ resource "unleash_segment" "segment1" {
 				// the upstream service, this can be removed.
 				ImportStateVerifyIgnore: []string{},
 			},
+			// ImportState by name testing
+			{
+				ResourceName:  "unleash_segment.segment1",
+				ImportStateId: "default/segment1",
+				Config: providerConf + `
+resource "unleash_segment" "segment1" {
+ project = "default"
+ name = "segment1"
+}`,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "unleash_segment.segment1",
+				ImportStateId: "name:segment1",
+				Config: providerConf + `
+resource "unleash_segment" "segment1" {
+ project = "default"
+ name = "segment1"
+}`,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "unleash_segment.segment1",
+				ImportStateId: "other/segment1",
+				Config: providerConf + `
+resource "unleash_segment" "segment1" {
+ project = "default"
+ name = "segment1"
+}`,
+				ImportState: true,
+				ExpectError: regexp.MustCompile(`segment segment1 is not found`),
+			},
 			//	Update and Read testing
 			{
 				Config: providerConf + `
@@ -106,3 +141,63 @@ resource "unleash_segment" "segment1" {
 		},
 	})
 }
+
+func testSegmentInUseFeatureConf(segments string) string {
+	return `
+resource "unleash_feature" "segmented" {
+	project = "default"
+	name = "test-feature.segment-in-use"
+	type = "release"
+	environments = {
+		development = {
+			enabled = true
+			strategies = [
+				{
+					name = "default"
+					disabled = false
+					segments = ` + segments + `
+				},
+			]
+		}
+	}
+}
+`
+}
+
+func TestAccSegmentResourceInUse(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConf + `
+resource "unleash_segment" "used" {
+	name = "used"
+}
+` + testSegmentInUseFeatureConf("[unleash_segment.used.id_int]"),
+			},
+			// Deleting a segment in use fails
+			{
+				Config:      providerConf + testSegmentInUseFeatureConf("[1]"),
+				ExpectError: regexp.MustCompile(`Segment Is In Use`),
+			},
+			{
+				Config: providerConf + `
+resource "unleash_segment" "used" {
+	name = "used"
+	force_detach = true
+}
+` + testSegmentInUseFeatureConf("[unleash_segment.used.id_int]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_segment.used", "force_detach", "true"),
+				),
+			},
+			// The segment is removed from the strategy, which drifts from the configuration
+			{
+				Config:             providerConf + testSegmentInUseFeatureConf("[1]"),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
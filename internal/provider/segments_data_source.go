@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &SegmentsDataSource{}
+
+func NewSegmentsDataSource() datasource.DataSource {
+	return &SegmentsDataSource{}
+}
+
+type SegmentsDataSource struct {
+	providerData UnleashProviderData
+}
+
+type SegmentsDataSourceModel struct {
+	Project  types.String   `tfsdk:"project"`
+	Segments []SegmentModel `tfsdk:"segments"`
+}
+
+func (d *SegmentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_segments"
+}
+
+func (d *SegmentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Segments data source",
+
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "Only return segments which belong to this project",
+				Optional:    true,
+			},
+			"segments": schema.ListNestedAttribute{
+				Description: "The matched segments sorted by ID",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: createSegmentDataSourceSchemaAttr(),
+				},
+			},
+		},
+	}
+}
+
+func (d *SegmentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.providerData = providerData
+}
+
+func (d *SegmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SegmentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	segments, err := getSegments(ctx, d.providerData.Client)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get segments", err.Error())
+		return
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].Id < segments[j].Id
+	})
+
+	data.Segments = make([]SegmentModel, 0, len(segments))
+	for _, segment := range segments {
+		if !data.Project.IsNull() && (segment.Project == nil || *segment.Project != data.Project.ValueString()) {
+			continue
+		}
+		segmentModel, err := toSegmentModel(&segment)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to convert segment", err.Error())
+			return
+		}
+		data.Segments = append(data.Segments, segmentModel)
+	}
+
+	tflog.Trace(ctx, "read data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+// StrategyDefinitionModel is a custom activation strategy. It is not named StrategyModel which is a strategy of a feature.
+type StrategyDefinitionModel struct {
+	Name        types.String             `tfsdk:"name"`
+	Title       types.String             `tfsdk:"title"`
+	Description types.String             `tfsdk:"description"`
+	Parameters  []StrategyParameterModel `tfsdk:"parameters"`
+	Deprecated  types.Bool               `tfsdk:"deprecated"`
+}
+
+type StrategyParameterModel struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	Required    types.Bool   `tfsdk:"required"`
+}
+
+func createStrategyDefinitionResourceSchemaAttr() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Description: "The name of this strategy which features refer to. This cannot be changed after the strategy is created.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"title": schema.StringAttribute{
+			Description: "The title of this strategy shown in the Unleash UI",
+			Optional:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "A description of this strategy",
+			Optional:    true,
+		},
+		"parameters": schema.ListNestedAttribute{
+			Description: "The parameters which features using this strategy pass to its implementation",
+			Optional:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "The name of the parameter",
+						Required:    true,
+					},
+					"type": schema.StringAttribute{
+						Description: "The type of the parameter. Valid values are string, percentage, list, number and boolean",
+						Required:    true,
+					},
+					"description": schema.StringAttribute{
+						Description: "A description of the parameter",
+						Optional:    true,
+					},
+					"required": schema.BoolAttribute{
+						Description: "Whether features using this strategy must set the parameter",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"deprecated": schema.BoolAttribute{
+			Description: "Whether this strategy is deprecated. Deprecated strategies cannot be added to features but existing feature strategies keep working",
+			Optional:    true,
+		},
+	}
+}
+
+func toStrategyDefinitionModel(strategy *unleash.StrategySchema) StrategyDefinitionModel {
+	strategyModel := StrategyDefinitionModel{
+		Name: types.StringValue(strategy.Name),
+	}
+	if strategy.Title != nil && *strategy.Title != "" {
+		strategyModel.Title = types.StringValue(*strategy.Title)
+	}
+	if strategy.Description != nil && *strategy.Description != "" {
+		strategyModel.Description = types.StringValue(*strategy.Description)
+	}
+	if len(strategy.Parameters) > 0 {
+		strategyModel.Parameters = make([]StrategyParameterModel, len(strategy.Parameters))
+		for i, parameter := range strategy.Parameters {
+			parameterModel := StrategyParameterModel{
+				Name: types.StringPointerValue(parameter.Name),
+				Type: types.StringPointerValue(parameter.Type),
+			}
+			if parameter.Description != nil && *parameter.Description != "" {
+				parameterModel.Description = types.StringValue(*parameter.Description)
+			}
+			if parameter.Required != nil && *parameter.Required {
+				parameterModel.Required = types.BoolValue(true)
+			}
+			strategyModel.Parameters[i] = parameterModel
+		}
+	}
+	if strategy.Deprecated {
+		strategyModel.Deprecated = types.BoolValue(true)
+	}
+
+	return strategyModel
+}
@@ -0,0 +1,32 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+func ensureStrategyDefinitionModelNullAndEmptyConsistency(strategyModel *StrategyDefinitionModel, strategyModelBefore StrategyDefinitionModel) {
+	tryUpdateToEmptyStringIfBeforeEmpty(strategyModel.Title, strategyModelBefore.Title, func(value types.String) {
+		strategyModel.Title = value
+	})
+	tryUpdateToEmptyStringIfBeforeEmpty(strategyModel.Description, strategyModelBefore.Description, func(value types.String) {
+		strategyModel.Description = value
+	})
+	tryUpdateToFalseIfBeforeFalse(strategyModel.Deprecated, strategyModelBefore.Deprecated, func(value types.Bool) {
+		strategyModel.Deprecated = value
+	})
+	if isNullArrayAndExistingEmptyArray(strategyModel.Parameters, strategyModelBefore.Parameters) {
+		strategyModel.Parameters = []StrategyParameterModel{}
+	} else if len(strategyModel.Parameters) == len(strategyModelBefore.Parameters) {
+		for i := range strategyModel.Parameters {
+			parameter := &strategyModel.Parameters[i]
+			parameterBefore := strategyModelBefore.Parameters[i]
+			if !parameter.Name.Equal(parameterBefore.Name) {
+				continue
+			}
+			tryUpdateToEmptyStringIfBeforeEmpty(parameter.Description, parameterBefore.Description, func(value types.String) {
+				parameter.Description = value
+			})
+			tryUpdateToFalseIfBeforeFalse(parameter.Required, parameterBefore.Required, func(value types.Bool) {
+				parameter.Required = value
+			})
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+var _ resource.Resource = &StrategyResource{}
+var _ resource.ResourceWithImportState = &StrategyResource{}
+
+func NewStrategyResource() resource.Resource {
+	return &StrategyResource{}
+}
+
+type StrategyResource struct {
+	providerData UnleashProviderData
+}
+
+type StrategyResourceModel struct {
+	StrategyDefinitionModel
+}
+
+func (r *StrategyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_strategy"
+}
+
+func (r *StrategyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Custom activation strategy resource. Strategies bundled with Unleash cannot be managed.",
+
+		Attributes: createStrategyDefinitionResourceSchemaAttr(),
+	}
+}
+
+func (r *StrategyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *StrategyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StrategyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateBody := toStrategyBody(data)
+	body := unleash.CreateStrategyJSONRequestBody{
+		Name:        data.Name.ValueString(),
+		Title:       updateBody.Title,
+		Description: updateBody.Description,
+		Deprecated:  ptr.ToPtr(data.Deprecated.ValueBool()),
+	}
+	body.Parameters = make([]struct {
+		Description *string                                    `json:"description,omitempty"`
+		Name        string                                     `json:"name"`
+		Required    *bool                                      `json:"required,omitempty"`
+		Type        unleash.CreateStrategySchemaParametersType `json:"type"`
+	}, len(updateBody.Parameters))
+	for i, parameter := range updateBody.Parameters {
+		body.Parameters[i].Description = parameter.Description
+		body.Parameters[i].Name = parameter.Name
+		body.Parameters[i].Required = parameter.Required
+		body.Parameters[i].Type = unleash.CreateStrategySchemaParametersType(parameter.Type)
+	}
+
+	tflog.Debug(ctx, "Creating strategy", map[string]interface{}{"body": body})
+	createResp, err := r.providerData.Client.CreateStrategyWithResponse(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create strategy "+data.Name.String(), err.Error())
+		return
+	}
+	if createResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to create strategy "+data.Name.String(), fmt.Sprintf(" with status %d %s", createResp.StatusCode(), string(createResp.Body)))
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StrategyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StrategyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading strategy", map[string]interface{}{"name": data.Name.ValueString()})
+	readResp, err := r.providerData.Client.GetStrategyWithResponse(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get strategy", err.Error())
+		return
+	}
+	if readResp.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if readResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to read strategy "+data.Name.String(), fmt.Sprintf(" with status %d %s", readResp.StatusCode(), string(readResp.Body)))
+		return
+	}
+	strategyModel := toStrategyDefinitionModel(readResp.JSON200)
+	ensureStrategyDefinitionModelNullAndEmptyConsistency(&strategyModel, data.StrategyDefinitionModel)
+	data.StrategyDefinitionModel = strategyModel
+
+	tflog.Trace(ctx, "read resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StrategyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data StrategyResourceModel
+	var existingData StrategyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &existingData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	strategyName := data.Name.ValueString()
+	body := toStrategyBody(data)
+	existingBody := toStrategyBody(existingData)
+	if !cmp.Equal(body, existingBody) {
+		tflog.Debug(ctx, "Updating strategy", map[string]interface{}{
+			"name": strategyName,
+			"body": body,
+		})
+		updateResp, err := r.providerData.Client.UpdateStrategyWithResponse(ctx, strategyName, body)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to update strategy "+data.Name.String(), err.Error())
+			return
+		}
+		if updateResp.StatusCode() > 299 {
+			resp.Diagnostics.AddError("failed to update strategy "+data.Name.String(), fmt.Sprintf(" with status %d %s", updateResp.StatusCode(), string(updateResp.Body)))
+			return
+		}
+	}
+
+	if data.Deprecated.ValueBool() && !existingData.Deprecated.ValueBool() {
+		tflog.Debug(ctx, "Deprecating strategy", map[string]interface{}{"name": strategyName})
+		deprecateResp, err := r.providerData.Client.DeprecateStrategyWithResponse(ctx, strategyName)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to deprecate strategy "+data.Name.String(), err.Error())
+			return
+		}
+		if deprecateResp.StatusCode() > 299 {
+			resp.Diagnostics.AddError("failed to deprecate strategy "+data.Name.String(), fmt.Sprintf(" with status %d %s", deprecateResp.StatusCode(), string(deprecateResp.Body)))
+			return
+		}
+	} else if !data.Deprecated.ValueBool() && existingData.Deprecated.ValueBool() {
+		tflog.Debug(ctx, "Reactivating strategy", map[string]interface{}{"name": strategyName})
+		reactivateResp, err := r.providerData.Client.ReactivateStrategyWithResponse(ctx, strategyName)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to reactivate strategy "+data.Name.String(), err.Error())
+			return
+		}
+		if reactivateResp.StatusCode() > 299 {
+			resp.Diagnostics.AddError("failed to reactivate strategy "+data.Name.String(), fmt.Sprintf(" with status %d %s", reactivateResp.StatusCode(), string(reactivateResp.Body)))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func toStrategyBody(data StrategyResourceModel) unleash.UpdateStrategyJSONRequestBody {
+	body := unleash.UpdateStrategyJSONRequestBody{
+		Description: data.Description.ValueStringPointer(),
+		Title:       data.Title.ValueStringPointer(),
+	}
+	if body.Description == nil {
+		body.Description = ptr.ToPtr("")
+	}
+	if body.Title == nil {
+		body.Title = ptr.ToPtr("")
+	}
+	body.Parameters = make([]struct {
+		Description *string                                    `json:"description,omitempty"`
+		Name        string                                     `json:"name"`
+		Required    *bool                                      `json:"required,omitempty"`
+		Type        unleash.UpdateStrategySchemaParametersType `json:"type"`
+	}, len(data.Parameters))
+	for i, parameter := range data.Parameters {
+		body.Parameters[i].Name = parameter.Name.ValueString()
+		body.Parameters[i].Type = unleash.UpdateStrategySchemaParametersType(parameter.Type.ValueString())
+		body.Parameters[i].Description = ptr.ToPtr(parameter.Description.ValueString())
+		body.Parameters[i].Required = ptr.ToPtr(parameter.Required.ValueBool())
+	}
+
+	return body
+}
+
+func (r *StrategyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data StrategyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting strategy", map[string]interface{}{"name": data.Name.ValueString()})
+	deleteResp, err := r.providerData.Client.RemoveStrategyWithResponse(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete strategy "+data.Name.String(), err.Error())
+		return
+	}
+	if deleteResp.StatusCode() > 299 && deleteResp.StatusCode() != 404 {
+		resp.Diagnostics.AddError("failed to delete strategy "+data.Name.String(), fmt.Sprintf(" with status %d %s", deleteResp.StatusCode(), string(deleteResp.Body)))
+		return
+	}
+}
+
+func (r *StrategyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,104 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestAccStrategyResource(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_strategy" "tenant_allowlist" {
+	name = "tenantAllowlist"
+	title = "Tenant allowlist"
+	description = "Enable for the listed tenants"
+	parameters = [
+		{
+			name = "tenants"
+			type = "list"
+			description = "Tenant IDs"
+			required = true
+		},
+		{
+			name = "rollout"
+			type = "percentage"
+		},
+	]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_strategy.tenant_allowlist", "name", "tenantAllowlist"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant_allowlist", "title", "Tenant allowlist"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant_allowlist", "description", "Enable for the listed tenants"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant_allowlist", "parameters.#", "2"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant_allowlist", "parameters.0.name", "tenants"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant_allowlist", "parameters.0.type", "list"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant_allowlist", "parameters.0.description", "Tenant IDs"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant_allowlist", "parameters.0.required", "true"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant_allowlist", "parameters.1.name", "rollout"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant_allowlist", "parameters.1.type", "percentage"),
+					resource.TestCheckNoResourceAttr("unleash_strategy.tenant_allowlist", "parameters.1.required"),
+					resource.TestCheckNoResourceAttr("unleash_strategy.tenant_allowlist", "deprecated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "unleash_strategy.tenant_allowlist",
+				ImportStateId:                        "tenantAllowlist",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			//	Update and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_strategy" "tenant_allowlist" {
+	name = "tenantAllowlist"
+	title = "Tenant allow list"
+	parameters = [
+		{
+			name = "tenants"
+			type = "list"
+			required = false
+		},
+	]
+	deprecated = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_strategy.tenant_allowlist", "name", "tenantAllowlist"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant_allowlist", "title", "Tenant allow list"),
+					resource.TestCheckNoResourceAttr("unleash_strategy.tenant_allowlist", "description"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant_allowlist", "parameters.#", "1"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant_allowlist", "parameters.0.required", "false"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant_allowlist", "deprecated", "true"),
+				),
+			},
+			// Reactivate testing
+			{
+				Config: providerConf + `
+resource "unleash_strategy" "tenant_allowlist" {
+	name = "tenantAllowlist"
+	title = "Tenant allowlist"
+	parameters = []
+	deprecated = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_strategy.tenant_allowlist", "parameters.#", "0"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant_allowlist", "deprecated", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+type TagTypeModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Icon        types.String `tfsdk:"icon"`
+}
+
+func createTagTypeResourceSchemaAttr() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Description: "The name of this tag type. This cannot be changed after the tag type is created.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"description": schema.StringAttribute{
+			Description: "A description of this tag type",
+			Optional:    true,
+		},
+		"icon": schema.StringAttribute{
+			Description: "The icon of this tag type",
+			Optional:    true,
+		},
+	}
+}
+
+func toTagTypeModel(tagType *unleash.TagTypeSchema) TagTypeModel {
+	tagTypeModel := TagTypeModel{
+		Name: types.StringValue(tagType.Name),
+	}
+	if tagType.Description != nil && *tagType.Description != "" {
+		tagTypeModel.Description = types.StringValue(*tagType.Description)
+	}
+	if tagType.Icon != nil && *tagType.Icon != "" {
+		tagTypeModel.Icon = types.StringValue(*tagType.Icon)
+	}
+
+	return tagTypeModel
+}
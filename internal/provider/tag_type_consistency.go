@@ -0,0 +1,12 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+func ensureTagTypeModelNullAndEmptyConsistency(tagTypeModel *TagTypeModel, tagTypeModelBefore TagTypeModel) {
+	tryUpdateToEmptyStringIfBeforeEmpty(tagTypeModel.Description, tagTypeModelBefore.Description, func(value types.String) {
+		tagTypeModel.Description = value
+	})
+	tryUpdateToEmptyStringIfBeforeEmpty(tagTypeModel.Icon, tagTypeModelBefore.Icon, func(value types.String) {
+		tagTypeModel.Icon = value
+	})
+}
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+var _ resource.Resource = &TagTypeResource{}
+var _ resource.ResourceWithImportState = &TagTypeResource{}
+
+func NewTagTypeResource() resource.Resource {
+	return &TagTypeResource{}
+}
+
+type TagTypeResource struct {
+	providerData UnleashProviderData
+}
+
+type TagTypeResourceModel struct {
+	TagTypeModel
+}
+
+func (r *TagTypeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag_type"
+}
+
+func (r *TagTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Tag type resource",
+
+		Attributes: createTagTypeResourceSchemaAttr(),
+	}
+}
+
+func (r *TagTypeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *TagTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TagTypeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateBody := toTagTypeBody(data)
+	body := unleash.CreateTagTypeJSONRequestBody{
+		Name:        data.Name.ValueString(),
+		Description: updateBody.Description,
+		Icon:        updateBody.Icon,
+	}
+
+	tflog.Debug(ctx, "Creating tag type", map[string]interface{}{"body": body})
+	createResp, err := r.providerData.Client.CreateTagTypeWithResponse(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create tag type "+data.Name.String(), err.Error())
+		return
+	}
+	if createResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to create tag type "+data.Name.String(), fmt.Sprintf(" with status %d %s", createResp.StatusCode(), string(createResp.Body)))
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TagTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TagTypeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading tag type", map[string]interface{}{"name": data.Name.ValueString()})
+	readResp, err := r.providerData.Client.GetTagTypeWithResponse(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get tag type", err.Error())
+		return
+	}
+	if readResp.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if readResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to read tag type "+data.Name.String(), fmt.Sprintf(" with status %d %s", readResp.StatusCode(), string(readResp.Body)))
+		return
+	}
+	tagTypeModel := toTagTypeModel(readResp.JSON200)
+	ensureTagTypeModelNullAndEmptyConsistency(&tagTypeModel, data.TagTypeModel)
+	data.TagTypeModel = tagTypeModel
+
+	tflog.Trace(ctx, "read resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TagTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TagTypeResourceModel
+	var existingData TagTypeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &existingData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagTypeBody := toTagTypeBody(data)
+	existingTagTypeBody := toTagTypeBody(existingData)
+	if !cmp.Equal(tagTypeBody, existingTagTypeBody) {
+		tflog.Debug(ctx, "Updating tag type", map[string]interface{}{
+			"name": data.Name.ValueString(),
+			"body": tagTypeBody,
+		})
+		updateResp, err := r.providerData.Client.UpdateTagTypeWithResponse(ctx, data.Name.ValueString(), tagTypeBody)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to update tag type "+data.Name.String(), err.Error())
+			return
+		}
+		if updateResp.StatusCode() > 299 {
+			resp.Diagnostics.AddError("failed to update tag type "+data.Name.String(), fmt.Sprintf(" with status %d %s", updateResp.StatusCode(), string(updateResp.Body)))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func toTagTypeBody(data TagTypeResourceModel) unleash.UpdateTagTypeJSONRequestBody {
+	body := unleash.UpdateTagTypeJSONRequestBody{
+		Description: data.Description.ValueStringPointer(),
+		Icon:        data.Icon.ValueStringPointer(),
+	}
+	if body.Description == nil {
+		body.Description = ptr.ToPtr("")
+	}
+	if body.Icon == nil {
+		body.Icon = ptr.ToPtr("")
+	}
+
+	return body
+}
+
+func (r *TagTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TagTypeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting tag type", map[string]interface{}{"name": data.Name.ValueString()})
+	deleteResp, err := r.providerData.Client.DeleteTagTypeWithResponse(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete tag type "+data.Name.String(), err.Error())
+		return
+	}
+	if deleteResp.StatusCode() > 299 && deleteResp.StatusCode() != 404 {
+		resp.Diagnostics.AddError("failed to delete tag type "+data.Name.String(), fmt.Sprintf(" with status %d %s", deleteResp.StatusCode(), string(deleteResp.Body)))
+		return
+	}
+}
+
+func (r *TagTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
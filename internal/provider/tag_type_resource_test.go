@@ -0,0 +1,57 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestAccTagTypeResource(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_tag_type" "team" {
+	name = "team"
+	description = "The team owning the feature"
+	icon = "people"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_tag_type.team", "name", "team"),
+					resource.TestCheckResourceAttr("unleash_tag_type.team", "description", "The team owning the feature"),
+					resource.TestCheckResourceAttr("unleash_tag_type.team", "icon", "people"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "unleash_tag_type.team",
+				ImportStateId:                        "team",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			//	Update and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_tag_type" "team" {
+	name = "team"
+	description = "Owner"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_tag_type.team", "name", "team"),
+					resource.TestCheckResourceAttr("unleash_tag_type.team", "description", "Owner"),
+					resource.TestCheckNoResourceAttr("unleash_tag_type.team", "icon"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
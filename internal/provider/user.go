@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+// predefinedRootRoles are the IDs of the root roles which Unleash creates by name.
+var predefinedRootRoles = map[string]int{
+	"Admin":  1,
+	"Editor": 2,
+	"Viewer": 3,
+}
+
+type UserModel struct {
+	ID        types.String `tfsdk:"id"`
+	Email     types.String `tfsdk:"email"`
+	Username  types.String `tfsdk:"username"`
+	Name      types.String `tfsdk:"name"`
+	RootRole  types.String `tfsdk:"root_role"`
+	SendEmail types.Bool   `tfsdk:"send_email"`
+}
+
+func createUserResourceSchemaAttr() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "ID of this user",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"email": schema.StringAttribute{
+			Description: "The email address of this user. Either email or username is required",
+			Optional:    true,
+		},
+		"username": schema.StringAttribute{
+			Description: "The username of this user. Either email or username is required. Changing it creates a new user.",
+			Optional:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of this user",
+			Optional:    true,
+		},
+		"root_role": schema.StringAttribute{
+			Description: "The root role of this user which is either Admin, Editor, Viewer or the ID of a custom root role",
+			Required:    true,
+		},
+		"send_email": schema.BoolAttribute{
+			Description: "Whether to send a welcome email when the user is created. Unleash sends it by default",
+			Optional:    true,
+		},
+	}
+}
+
+func toUserModel(user *unleash.UserSchema) UserModel {
+	userModel := UserModel{
+		ID: types.StringValue(strconv.Itoa(user.Id)),
+	}
+	if user.Email != nil && *user.Email != "" {
+		userModel.Email = types.StringValue(*user.Email)
+	}
+	if user.Username != nil && *user.Username != "" {
+		userModel.Username = types.StringValue(*user.Username)
+	}
+	if user.Name != nil && *user.Name != "" {
+		userModel.Name = types.StringValue(*user.Name)
+	}
+	if user.RootRole != nil {
+		userModel.RootRole = types.StringValue(strconv.Itoa(*user.RootRole))
+	}
+
+	return userModel
+}
@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func ensureUserModelNullAndEmptyConsistency(userModel *UserModel, userModelBefore UserModel) {
+	tryUpdateToEmptyStringIfBeforeEmpty(userModel.Email, userModelBefore.Email, func(value types.String) {
+		userModel.Email = value
+	})
+	tryUpdateToEmptyStringIfBeforeEmpty(userModel.Username, userModelBefore.Username, func(value types.String) {
+		userModel.Username = value
+	})
+	tryUpdateToEmptyStringIfBeforeEmpty(userModel.Name, userModelBefore.Name, func(value types.String) {
+		userModel.Name = value
+	})
+	// Unleash responds the ID of a root role which was given by name
+	if id, ok := predefinedRootRoles[userModelBefore.RootRole.ValueString()]; ok && userModel.RootRole.ValueString() == strconv.Itoa(id) {
+		userModel.RootRole = userModelBefore.RootRole
+	}
+}
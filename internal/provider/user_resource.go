@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+type UserResource struct {
+	providerData UnleashProviderData
+}
+
+type UserResourceModel struct {
+	UserModel
+}
+
+func (r *UserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "User resource. A user is imported by its ID.",
+
+		Attributes: createUserResourceSchemaAttr(),
+	}
+}
+
+func (r *UserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(UnleashProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.providerData = providerData
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := toCreateUserBody(data)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create user "+toUserDisplayName(data), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Creating user", map[string]interface{}{"body": body})
+	createResp, err := r.providerData.Client.CreateUserWithResponse(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create user "+toUserDisplayName(data), err.Error())
+		return
+	}
+	if createResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to create user "+toUserDisplayName(data), fmt.Sprintf(" with status %d %s", createResp.StatusCode(), string(createResp.Body)))
+		return
+	}
+	data.ID = types.StringValue(strconv.Itoa(createResp.JSON201.Id))
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading user", map[string]interface{}{"id": data.ID.ValueString()})
+	readResp, err := r.providerData.Client.GetUserWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get user", err.Error())
+		return
+	}
+	if readResp.StatusCode() == 404 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if readResp.StatusCode() > 299 {
+		resp.Diagnostics.AddError("failed to read user "+data.ID.String(), fmt.Sprintf(" with status %d %s", readResp.StatusCode(), string(readResp.Body)))
+		return
+	}
+	userModel := toUserModel(readResp.JSON200)
+	ensureUserModelNullAndEmptyConsistency(&userModel, data.UserModel)
+	// Unleash does not return whether the welcome email was requested
+	userModel.SendEmail = data.SendEmail
+	data.UserModel = userModel
+
+	tflog.Trace(ctx, "read resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserResourceModel
+	var existingData UserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &existingData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// send_email only applies to new users so changing it alone updates nothing
+	if !data.Email.Equal(existingData.Email) || !data.Name.Equal(existingData.Name) || !data.RootRole.Equal(existingData.RootRole) {
+		body, err := toUpdateUserBody(data)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to update user "+data.ID.String(), err.Error())
+			return
+		}
+		tflog.Debug(ctx, "Updating user", map[string]interface{}{
+			"id":   data.ID.ValueString(),
+			"body": body,
+		})
+		updateResp, err := r.providerData.Client.UpdateUserWithResponse(ctx, data.ID.ValueString(), body)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to update user "+data.ID.String(), err.Error())
+			return
+		}
+		if updateResp.StatusCode() > 299 {
+			resp.Diagnostics.AddError("failed to update user "+data.ID.String(), fmt.Sprintf(" with status %d %s", updateResp.StatusCode(), string(updateResp.Body)))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func toCreateUserBody(data UserResourceModel) (unleash.CreateUserJSONRequestBody, error) {
+	body := unleash.CreateUserJSONRequestBody{
+		Email:     data.Email.ValueStringPointer(),
+		Username:  data.Username.ValueStringPointer(),
+		Name:      data.Name.ValueStringPointer(),
+		SendEmail: data.SendEmail.ValueBoolPointer(),
+	}
+	var err error
+	if id, atoiErr := strconv.Atoi(data.RootRole.ValueString()); atoiErr == nil {
+		err = body.RootRole.FromCreateUserSchemaRootRole0(id)
+	} else {
+		err = body.RootRole.FromCreateUserSchemaRootRole1(unleash.CreateUserSchemaRootRole1(data.RootRole.ValueString()))
+	}
+
+	return body, err
+}
+
+func toUpdateUserBody(data UserResourceModel) (unleash.UpdateUserJSONRequestBody, error) {
+	body := unleash.UpdateUserJSONRequestBody{
+		Email:    data.Email.ValueStringPointer(),
+		Name:     data.Name.ValueStringPointer(),
+		RootRole: &unleash.UpdateUserSchema_RootRole{},
+	}
+	if body.Email == nil {
+		body.Email = ptr.ToPtr("")
+	}
+	if body.Name == nil {
+		body.Name = ptr.ToPtr("")
+	}
+	var err error
+	if id, atoiErr := strconv.Atoi(data.RootRole.ValueString()); atoiErr == nil {
+		err = body.RootRole.FromUpdateUserSchemaRootRole0(id)
+	} else {
+		err = body.RootRole.FromUpdateUserSchemaRootRole1(unleash.UpdateUserSchemaRootRole1(data.RootRole.ValueString()))
+	}
+
+	return body, err
+}
+
+// toUserDisplayName returns the email or the username which identifies a user before it has an ID.
+func toUserDisplayName(data UserResourceModel) string {
+	if !data.Email.IsNull() {
+		return data.Email.String()
+	}
+
+	return data.Username.String()
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting user", map[string]interface{}{"id": data.ID.ValueString()})
+	deleteResp, err := r.providerData.Client.DeleteUserWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete user "+data.ID.String(), err.Error())
+		return
+	}
+	if deleteResp.StatusCode() > 299 && deleteResp.StatusCode() != 404 {
+		resp.Diagnostics.AddError("failed to delete user "+data.ID.String(), fmt.Sprintf(" with status %d %s", deleteResp.StatusCode(), string(deleteResp.Body)))
+		return
+	}
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,62 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+)
+
+func TestAccUserResource(t *testing.T) {
+	providerConf := getProviderConf(inmem.CreateTestServer().Start(t), "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_user" "jane" {
+	email = "jane@example.com"
+	name = "Jane"
+	root_role = "Editor"
+	send_email = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unleash_user.jane", "id"),
+					resource.TestCheckResourceAttr("unleash_user.jane", "email", "jane@example.com"),
+					resource.TestCheckNoResourceAttr("unleash_user.jane", "username"),
+					resource.TestCheckResourceAttr("unleash_user.jane", "name", "Jane"),
+					resource.TestCheckResourceAttr("unleash_user.jane", "root_role", "Editor"),
+					resource.TestCheckResourceAttr("unleash_user.jane", "send_email", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "unleash_user.jane",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the root role is imported by ID and send_email is only used on creation
+				ImportStateVerifyIgnore: []string{"root_role", "send_email"},
+			},
+			//	Update and Read testing
+			{
+				Config: providerConf + `
+resource "unleash_user" "jane" {
+	email = "jane.doe@example.com"
+	root_role = "Viewer"
+	send_email = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_user.jane", "email", "jane.doe@example.com"),
+					resource.TestCheckNoResourceAttr("unleash_user.jane", "name"),
+					resource.TestCheckResourceAttr("unleash_user.jane", "root_role", "Viewer"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
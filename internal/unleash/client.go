@@ -1,16 +1,87 @@
 package unleash
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"os"
 	"time"
 )
 
-func CreateClient(baseURL string, authorizationToken string) (ClientWithResponsesInterface, error) {
+// TLSConfig configures TLS of the connections to Unleash.
+type TLSConfig struct {
+	// CACertFile is a path to PEM encoded CA certificates which are trusted in addition to the system ones.
+	CACertFile string
+	// ClientCertFile is a path to PEM encoded client certificate for mutual TLS. ClientKeyFile must be set as well.
+	ClientCertFile string
+	// ClientKeyFile is a path to PEM encoded private key of the client certificate.
+	ClientKeyFile string
+	// InsecureSkipVerify disables verification of the server certificate.
+	InsecureSkipVerify bool
+}
+
+type createClientConfig struct {
+	retry    RetryConfig
+	timeout  time.Duration
+	tls      TLSConfig
+	proxyURL *url.URL
+}
+
+// CreateClientOption customizes the client created by CreateClient.
+type CreateClientOption func(config *createClientConfig)
+
+// WithRetry replaces DefaultRetryConfig which is used to retry failed requests.
+func WithRetry(retry RetryConfig) CreateClientOption {
+	return func(config *createClientConfig) {
+		config.retry = retry
+	}
+}
+
+// WithTimeout replaces the default 60 seconds timeout of a request including its retries.
+func WithTimeout(timeout time.Duration) CreateClientOption {
+	return func(config *createClientConfig) {
+		config.timeout = timeout
+	}
+}
+
+// WithTLS configures TLS of the connections.
+func WithTLS(tlsConfig TLSConfig) CreateClientOption {
+	return func(config *createClientConfig) {
+		config.tls = tlsConfig
+	}
+}
+
+// WithProxy sends requests through the proxy instead of the one from HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+func WithProxy(proxyURL *url.URL) CreateClientOption {
+	return func(config *createClientConfig) {
+		config.proxyURL = proxyURL
+	}
+}
+
+func CreateClient(baseURL string, authorizationToken string, options ...CreateClientOption) (ClientWithResponsesInterface, error) {
+	config := createClientConfig{
+		retry:   DefaultRetryConfig(),
+		timeout: 60 * time.Second,
+	}
+	for _, option := range options {
+		option(&config)
+	}
+
+	transport, err := createTransport(config)
+	if err != nil {
+		return nil, err
+	}
 	hc := http.Client{
-		Timeout: 60 * time.Second,
+		Timeout: config.timeout,
 	}
 	hc.Transport = authHeaderTransport{
-		roundTripper:       http.DefaultTransport,
+		roundTripper: retryTransport{
+			roundTripper: transport,
+			config:       config.retry,
+		},
 		authorizationToken: authorizationToken,
 	}
 
@@ -22,6 +93,56 @@ func CreateClient(baseURL string, authorizationToken string) (ClientWithResponse
 	return c, nil
 }
 
+func createTransport(config createClientConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.proxyURL != nil {
+		transport.Proxy = http.ProxyURL(config.proxyURL)
+	}
+
+	tlsConfig, err := createTLSConfig(config.tls)
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+func createTLSConfig(config TLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if config.CACertFile != "" {
+		pem, err := os.ReadFile(config.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificates: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no PEM encoded CA certificate is found in %s", config.CACertFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCertFile != "" || config.ClientKeyFile != "" {
+		if config.ClientCertFile == "" || config.ClientKeyFile == "" {
+			return nil, errors.New("both client certificate and client key are required for mutual TLS")
+		}
+		cert, err := tls.LoadX509KeyPair(config.ClientCertFile, config.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
 type authHeaderTransport struct {
 	roundTripper       http.RoundTripper
 	authorizationToken string
@@ -0,0 +1,187 @@
+package unleash_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+func TestCreateClientTLS(t *testing.T) {
+	var clientCertificates int
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientCertificates = len(r.TLS.PeerCertificates)
+		writeProjects(w)
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequestClientCert,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	dir := t.TempDir()
+	caCertFile := writePEM(t, dir, "ca.pem", "CERTIFICATE", server.Certificate().Raw)
+	clientCertFile, clientKeyFile := writeClientCertificate(t, dir)
+
+	var testCases = []struct {
+		name                       string
+		tlsConfig                  unleash.TLSConfig
+		expectedError              bool
+		expectedClientCertificates int
+	}{
+		{
+			name:          "untrusted server",
+			tlsConfig:     unleash.TLSConfig{},
+			expectedError: true,
+		},
+		{
+			name: "CA certificates",
+			tlsConfig: unleash.TLSConfig{
+				CACertFile: caCertFile,
+			},
+		},
+		{
+			name: "insecure skip verify",
+			tlsConfig: unleash.TLSConfig{
+				InsecureSkipVerify: true,
+			},
+		},
+		{
+			name: "client certificate",
+			tlsConfig: unleash.TLSConfig{
+				CACertFile:     caCertFile,
+				ClientCertFile: clientCertFile,
+				ClientKeyFile:  clientKeyFile,
+			},
+			expectedClientCertificates: 1,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			clientCertificates = 0
+			client, err := unleash.CreateClient(server.URL, "any", unleash.WithTLS(testCase.tlsConfig), unleash.WithRetry(unleash.RetryConfig{}))
+			require.NoError(t, err)
+
+			resp, err := client.GetProjectsWithResponse(context.Background())
+			if testCase.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode())
+			assert.Equal(t, testCase.expectedClientCertificates, clientCertificates)
+		})
+	}
+}
+
+func TestCreateClientInvalidTLS(t *testing.T) {
+	dir := t.TempDir()
+	notPEMFile := filepath.Join(dir, "not.pem")
+	require.NoError(t, os.WriteFile(notPEMFile, []byte("not a certificate"), 0o600))
+	clientCertFile, _ := writeClientCertificate(t, dir)
+
+	var testCases = []struct {
+		name      string
+		tlsConfig unleash.TLSConfig
+	}{
+		{
+			name:      "missing CA certificates",
+			tlsConfig: unleash.TLSConfig{CACertFile: filepath.Join(dir, "missing.pem")},
+		},
+		{
+			name:      "invalid CA certificates",
+			tlsConfig: unleash.TLSConfig{CACertFile: notPEMFile},
+		},
+		{
+			name:      "client certificate without key",
+			tlsConfig: unleash.TLSConfig{ClientCertFile: clientCertFile},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := unleash.CreateClient("https://localhost", "any", unleash.WithTLS(testCase.tlsConfig))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCreateClientProxy(t *testing.T) {
+	var proxiedHost string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHost = r.URL.Host
+		writeProjects(w)
+	}))
+	defer proxy.Close()
+	proxyURL, err := url.Parse(proxy.URL)
+	require.NoError(t, err)
+
+	client, err := unleash.CreateClient("http://unleash.example.com", "any", unleash.WithProxy(proxyURL))
+	require.NoError(t, err)
+
+	resp, err := client.GetProjectsWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+	assert.Equal(t, "unleash.example.com", proxiedHost)
+}
+
+func TestCreateClientTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		writeProjects(w)
+	}))
+	defer server.Close()
+
+	client, err := unleash.CreateClient(server.URL, "any", unleash.WithTimeout(50*time.Millisecond))
+	require.NoError(t, err)
+
+	_, err = client.GetProjectsWithResponse(context.Background())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func writeProjects(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"version":1,"projects":[]}`))
+}
+
+func writeClientCertificate(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	return writePEM(t, dir, "client.pem", "CERTIFICATE", cert), writePEM(t, dir, "client-key.pem", "PRIVATE KEY", keyDER)
+}
+
+func writePEM(t *testing.T, dir string, name string, blockType string, der []byte) string {
+	file := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+
+	return file
+}
@@ -2,7 +2,9 @@ package unleash
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 )
 
 type FetchedFeature struct {
@@ -19,22 +21,115 @@ type FetchedEnvironment struct {
 	FetchedStrategies []FeatureStrategySchema
 }
 
-func GetFeatures(ctx context.Context, client ClientWithResponsesInterface, projectID string) ([]FetchedFeature, error) {
+// ErrProjectNotFound is returned by GetFeatures when the project does not exist.
+var ErrProjectNotFound = errors.New("project not found")
+
+// DefaultFetchConcurrency is the default number of features which GetFeatures fetches concurrently.
+const DefaultFetchConcurrency = 8
+
+type getFeaturesConfig struct {
+	concurrency int
+}
+
+// GetFeaturesOption customizes how GetFeatures fetches features.
+type GetFeaturesOption func(config *getFeaturesConfig)
+
+// WithConcurrency limits the number of features which are fetched concurrently. Features are fetched sequentially if it is less than 2.
+func WithConcurrency(concurrency int) GetFeaturesOption {
+	return func(config *getFeaturesConfig) {
+		config.concurrency = concurrency
+	}
+}
+
+// GetFeatures fetches all features of the project including their environments with a bounded pool of workers.
+// The features are returned in the order listed by Unleash. Errors of all failed features are joined.
+func GetFeatures(ctx context.Context, client ClientWithResponsesInterface, projectID string, options ...GetFeaturesOption) ([]FetchedFeature, error) {
+	config := getFeaturesConfig{
+		concurrency: DefaultFetchConcurrency,
+	}
+	for _, option := range options {
+		option(&config)
+	}
+
 	featuresResp, err := client.GetFeaturesWithResponse(ctx, projectID)
 	if err != nil {
 		return nil, err
 	}
+	if featuresResp.StatusCode() == 404 {
+		return nil, fmt.Errorf("failed to get features from project %s: %w", projectID, ErrProjectNotFound)
+	}
 	if featuresResp.StatusCode() > 299 {
 		return nil, fmt.Errorf("failed to get features from project %s with status %d %s", projectID, featuresResp.StatusCode(), string(featuresResp.Body))
 	}
 
+	features := featuresResp.JSON200.Features
+	fetchedFeatures := make([]FetchedFeature, len(features))
+	errs := make([]error, len(features))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(max(config.concurrency, 1), len(features)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				fetchedFeatures[i], errs[i] = fetchFeatureProperties(ctx, client, projectID, features[i])
+			}
+		}()
+	}
+
+dispatch:
+	for i := range features {
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case indexes <- i:
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return fetchedFeatures, nil
+}
+
+// GetArchivedFeatures lists archived features of the project. Their environments are not fetched since
+// Unleash does not serve strategies and variants of archived features, so only those listed are returned.
+func GetArchivedFeatures(ctx context.Context, client ClientWithResponsesInterface, projectID string) ([]FetchedFeature, error) {
+	featuresResp, err := client.GetArchivedFeaturesByProjectIdWithResponse(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if featuresResp.StatusCode() > 299 {
+		return nil, fmt.Errorf("failed to get archived features from project %s with status %d %s", projectID, featuresResp.StatusCode(), string(featuresResp.Body))
+	}
+
 	fetchedFeatures := make([]FetchedFeature, 0, len(featuresResp.JSON200.Features))
 	for _, feature := range featuresResp.JSON200.Features {
-		fetched, err := fetchFeatureProperties(ctx, client, projectID, feature)
-		if err != nil {
-			return nil, err
+		fetchedFeature := FetchedFeature{
+			Feature:        feature,
+			FetchedProject: projectID,
+		}
+		if feature.Environments != nil {
+			for _, env := range *feature.Environments {
+				fetchedEnv := FetchedEnvironment{
+					Environment: env,
+				}
+				if env.Variants != nil {
+					fetchedEnv.FetchedVariants = *env.Variants
+				}
+				if env.Strategies != nil {
+					fetchedEnv.FetchedStrategies = *env.Strategies
+				}
+				fetchedFeature.FetchedEnvironments = append(fetchedFeature.FetchedEnvironments, fetchedEnv)
+			}
 		}
-		fetchedFeatures = append(fetchedFeatures, fetched)
+		fetchedFeatures = append(fetchedFeatures, fetchedFeature)
 	}
 
 	return fetchedFeatures, nil
@@ -0,0 +1,99 @@
+package unleash_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+func TestGetFeatures(t *testing.T) {
+	server := inmem.CreateTestServer()
+	client := createFeatures(t, server, 20)
+	ctx := context.Background()
+
+	sequential, err := unleash.GetFeatures(ctx, client, "default", unleash.WithConcurrency(1))
+	require.NoError(t, err)
+	require.Len(t, sequential, 20)
+	for i, fetched := range sequential {
+		assert.Equal(t, fmt.Sprintf("test.feature.%03d", i), fetched.Feature.Name)
+		assert.Len(t, fetched.FetchedEnvironments, 2)
+	}
+
+	concurrent, err := unleash.GetFeatures(ctx, client, "default", unleash.WithConcurrency(8))
+	require.NoError(t, err)
+	assert.Equal(t, sequential, concurrent)
+}
+
+func TestGetFeaturesErrors(t *testing.T) {
+	server := inmem.CreateTestServer()
+	client := createFeatures(t, server, 4)
+	for _, featureName := range []string{"test.feature.001", "test.feature.003"} {
+		server.InjectFault(inmem.Fault{
+			PathPrefix: "/api/admin/projects/default/features/" + featureName + "/",
+			StatusCode: http.StatusInternalServerError,
+		})
+	}
+
+	_, err := unleash.GetFeatures(context.Background(), client, "default")
+	require.Error(t, err)
+	assert.Equal(t, 2, strings.Count(err.Error(), "with status 500"))
+	assert.Contains(t, err.Error(), "test.feature.001")
+	assert.Contains(t, err.Error(), "test.feature.003")
+}
+
+func TestGetFeaturesCancel(t *testing.T) {
+	server := inmem.CreateTestServer()
+	client := createFeatures(t, server, 20)
+	server.SetLatency(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := unleash.GetFeatures(ctx, client, "default", unleash.WithConcurrency(2))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func BenchmarkGetFeatures(b *testing.B) {
+	server := inmem.CreateTestServer()
+	client := createFeatures(b, server, 50)
+	server.SetLatency(5 * time.Millisecond)
+
+	for _, concurrency := range []int{1, unleash.DefaultFetchConcurrency, 32} {
+		b.Run(fmt.Sprintf("concurrency=%d", concurrency), func(b *testing.B) {
+			for range b.N {
+				_, err := unleash.GetFeatures(context.Background(), client, "default", unleash.WithConcurrency(concurrency))
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func createFeatures(tb testing.TB, server *inmem.TestServer, count int) unleash.ClientWithResponsesInterface {
+	tb.Helper()
+	port := server.Start(tb)
+	client, err := unleash.CreateClient("http://localhost:"+strconv.Itoa(port), "any", unleash.WithRetry(unleash.RetryConfig{}))
+	require.NoError(tb, err)
+
+	for i := range count {
+		resp, err := client.CreateFeatureWithResponse(context.Background(), "default", unleash.CreateFeatureJSONRequestBody{
+			Name: fmt.Sprintf("test.feature.%03d", i),
+			Type: ptr.ToPtr("release"),
+		})
+		require.NoError(tb, err)
+		require.Equal(tb, http.StatusOK, resp.StatusCode())
+	}
+
+	return client
+}
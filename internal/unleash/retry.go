@@ -0,0 +1,150 @@
+package unleash
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryConfig configures how failed requests are retried.
+type RetryConfig struct {
+	// MaxRetries is the maximum number of retries of a request. Requests are not retried if it is 0.
+	MaxRetries int
+	// MinBackoff is the wait time before the first retry. The wait time is doubled for each following retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the wait time between retries including the wait time requested by Retry-After header.
+	MaxBackoff time.Duration
+}
+
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries: 3,
+		MinBackoff: 500 * time.Millisecond,
+		MaxBackoff: 30 * time.Second,
+	}
+}
+
+// retryTransport retries requests which failed with a transient error.
+//
+// Only idempotent requests are retried after a network error or a gateway error since the server may have already handled
+// the request, e.g. retrying a POST which adds a strategy could add the strategy twice. 429 Too Many Requests is retried
+// regardless of the method since the server rejects the request without handling it.
+type retryTransport struct {
+	roundTripper http.RoundTripper
+	config       RetryConfig
+}
+
+// RoundTrip sends each retry as a clone of req with a fresh body so that req itself is never modified.
+func (t retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attemptReq := req
+	for attempt := 0; ; attempt++ {
+		res, err := t.roundTripper.RoundTrip(attemptReq)
+		if attempt >= t.config.MaxRetries || !shouldRetry(req, res, err) {
+			return res, err
+		}
+		wait := t.backoff(attempt)
+		if res != nil {
+			if retryAfter, ok := parseRetryAfter(res.Header.Get("Retry-After"), time.Now()); ok {
+				wait = min(retryAfter, t.config.MaxBackoff)
+			}
+		}
+		if deadline, ok := req.Context().Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			// waiting would only end in a deadline error rather than the actual response
+			return res, err
+		}
+		nextReq := req.Clone(req.Context())
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				// the body cannot be sent again
+				return res, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return res, err
+			}
+			nextReq.Body = body
+		}
+
+		if res != nil {
+			// the connection can only be reused if the body is fully read
+			_, _ = io.Copy(io.Discard, res.Body)
+			_ = res.Body.Close()
+		}
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+		attemptReq = nextReq
+	}
+}
+
+func shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if err != nil {
+		return isIdempotent(req.Method) && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	default:
+		return false
+	}
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoff returns an exponential backoff with jitter between half and the whole of the backoff.
+func (t retryTransport) backoff(attempt int) time.Duration {
+	backoff := t.config.MaxBackoff
+	if attempt < 32 {
+		backoff = min(t.config.MinBackoff<<attempt, t.config.MaxBackoff)
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	half := backoff / 2
+
+	return half + rand.N(backoff-half+1)
+}
+
+// parseRetryAfter parses the value of Retry-After header which is either delay seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return max(date.Sub(now), 0), true
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,183 @@
+package unleash_test
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/inmem"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/ptr"
+	"github.com/LINEMANWongnai/terraform-provider-unleash/internal/unleash"
+)
+
+func TestRetry(t *testing.T) {
+	server := inmem.CreateTestServer()
+	port := server.Start(t)
+
+	client, err := unleash.CreateClient("http://localhost:"+strconv.Itoa(port), "any", unleash.WithRetry(unleash.RetryConfig{
+		MaxRetries: 2,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 10 * time.Millisecond,
+	}))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	createResp, err := client.CreateFeatureWithResponse(ctx, "default", unleash.CreateFeatureJSONRequestBody{
+		Name: "test.feature.retry",
+		Type: ptr.ToPtr("release"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, createResp.StatusCode())
+
+	var testCases = []struct {
+		name                   string
+		faults                 []inmem.Fault
+		call                   func() int
+		expectedStatusCode     int
+		expectedInjectedFaults int
+	}{
+		{
+			name: "retry GET on gateway errors",
+			faults: []inmem.Fault{
+				{StatusCode: http.StatusBadGateway, Times: 1},
+				{StatusCode: http.StatusServiceUnavailable, Times: 1},
+			},
+			call:                   getFeature(ctx, client),
+			expectedStatusCode:     http.StatusOK,
+			expectedInjectedFaults: 2,
+		},
+		{
+			name: "give up after max retries",
+			faults: []inmem.Fault{
+				{StatusCode: http.StatusGatewayTimeout},
+			},
+			call:                   getFeature(ctx, client),
+			expectedStatusCode:     http.StatusGatewayTimeout,
+			expectedInjectedFaults: 3,
+		},
+		{
+			name: "retry POST on too many requests",
+			faults: []inmem.Fault{
+				{StatusCode: http.StatusTooManyRequests, RetryAfter: "0", Times: 2},
+			},
+			call:                   addStrategy(ctx, client),
+			expectedStatusCode:     http.StatusOK,
+			expectedInjectedFaults: 2,
+		},
+		{
+			name: "cap retry after by max backoff",
+			faults: []inmem.Fault{
+				{StatusCode: http.StatusTooManyRequests, RetryAfter: "3600", Times: 1},
+			},
+			call:                   getFeature(ctx, client),
+			expectedStatusCode:     http.StatusOK,
+			expectedInjectedFaults: 1,
+		},
+		{
+			name: "do not retry POST on gateway errors",
+			faults: []inmem.Fault{
+				{StatusCode: http.StatusBadGateway, Times: 1},
+			},
+			call:                   addStrategy(ctx, client),
+			expectedStatusCode:     http.StatusBadGateway,
+			expectedInjectedFaults: 1,
+		},
+		{
+			name: "inject to every n-th request",
+			faults: []inmem.Fault{
+				{Method: http.MethodGet, StatusCode: http.StatusBadGateway, Every: 2},
+			},
+			call: func() int {
+				for i := 0; i < 3; i++ {
+					if statusCode := getFeature(ctx, client)(); statusCode != http.StatusOK {
+						return statusCode
+					}
+				}
+				return http.StatusOK
+			},
+			expectedStatusCode:     http.StatusOK,
+			expectedInjectedFaults: 2,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			server.ClearFaults()
+			injectedFaults := server.InjectedFaults()
+			for _, fault := range testCase.faults {
+				server.InjectFault(fault)
+			}
+
+			assert.Equal(t, testCase.expectedStatusCode, testCase.call())
+			assert.Equal(t, testCase.expectedInjectedFaults, server.InjectedFaults()-injectedFaults)
+		})
+	}
+}
+
+func TestRetryCancel(t *testing.T) {
+	server := inmem.CreateTestServer()
+	port := server.Start(t)
+
+	client, err := unleash.CreateClient("http://localhost:"+strconv.Itoa(port), "any", unleash.WithRetry(unleash.RetryConfig{
+		MaxRetries: 2,
+		MinBackoff: time.Minute,
+		MaxBackoff: time.Minute,
+	}))
+	require.NoError(t, err)
+	server.InjectFault(inmem.Fault{StatusCode: http.StatusTooManyRequests, RetryAfter: "30"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	_, err = client.GetFeaturesWithResponse(ctx, "default")
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, server.InjectedFaults())
+}
+
+func TestRetryAfterDeadline(t *testing.T) {
+	server := inmem.CreateTestServer()
+	port := server.Start(t)
+
+	client, err := unleash.CreateClient("http://localhost:"+strconv.Itoa(port), "any", unleash.WithRetry(unleash.RetryConfig{
+		MaxRetries: 2,
+		MinBackoff: time.Minute,
+		MaxBackoff: time.Minute,
+	}))
+	require.NoError(t, err)
+	server.InjectFault(inmem.Fault{StatusCode: http.StatusTooManyRequests, RetryAfter: "60"})
+
+	// the response is returned as it is rather than waiting for a retry which cannot happen before the deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	start := time.Now()
+	resp, err := client.GetFeaturesWithResponse(ctx, "default")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode())
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, 1, server.InjectedFaults())
+}
+
+func getFeature(ctx context.Context, client unleash.ClientWithResponsesInterface) func() int {
+	return func() int {
+		resp, err := client.GetFeatureWithResponse(ctx, "default", "test.feature.retry")
+		if err != nil {
+			return 0
+		}
+		return resp.StatusCode()
+	}
+}
+
+func addStrategy(ctx context.Context, client unleash.ClientWithResponsesInterface) func() int {
+	return func() int {
+		resp, err := client.AddFeatureStrategyWithResponse(ctx, "default", "test.feature.retry", "development", unleash.AddFeatureStrategyJSONRequestBody{
+			Name: "default",
+		})
+		if err != nil {
+			return 0
+		}
+		return resp.StatusCode()
+	}
+}
@@ -67,6 +67,13 @@ const (
 	ConstraintSchemaOperatorSTRSTARTSWITH ConstraintSchemaOperator = "STR_STARTS_WITH"
 )
 
+// Defines values for CreateProjectSchemaMode.
+const (
+	CreateProjectSchemaModeOpen      CreateProjectSchemaMode = "open"
+	CreateProjectSchemaModePrivate   CreateProjectSchemaMode = "private"
+	CreateProjectSchemaModeProtected CreateProjectSchemaMode = "protected"
+)
+
 // Defines values for CreateStrategySchemaParametersType.
 const (
 	CreateStrategySchemaParametersTypeBoolean    CreateStrategySchemaParametersType = "boolean"
@@ -367,6 +374,13 @@ const (
 	PlaygroundStrategySchemaResult1VariantPayloadTypeString PlaygroundStrategySchemaResult1VariantPayloadType = "string"
 )
 
+// Defines values for ProjectCreatedSchemaMode.
+const (
+	ProjectCreatedSchemaModeOpen      ProjectCreatedSchemaMode = "open"
+	ProjectCreatedSchemaModePrivate   ProjectCreatedSchemaMode = "private"
+	ProjectCreatedSchemaModeProtected ProjectCreatedSchemaMode = "protected"
+)
+
 // Defines values for ProjectOverviewSchemaMode.
 const (
 	ProjectOverviewSchemaModeOpen      ProjectOverviewSchemaMode = "open"
@@ -560,6 +574,13 @@ const (
 	OpenSource UiConfigSchemaAuthenticationType = "open-source"
 )
 
+// Defines values for UpdateProjectSchemaMode.
+const (
+	Open      UpdateProjectSchemaMode = "open"
+	Private   UpdateProjectSchemaMode = "private"
+	Protected UpdateProjectSchemaMode = "protected"
+)
+
 // Defines values for UpdateStrategySchemaParametersType.
 const (
 	UpdateStrategySchemaParametersTypeBoolean    UpdateStrategySchemaParametersType = "boolean"
@@ -1456,6 +1477,27 @@ type CreateDependentFeatureSchema struct {
 	Variants *[]string `json:"variants,omitempty"`
 }
 
+// CreateEnvironmentSchema Data used to create a new [environment](https://docs.getunleash.io/reference/environments).
+type CreateEnvironmentSchema struct {
+	// Enabled Newly created environments are enabled by default. Set this property to `false` to create the environment in a disabled state.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Name The name of the environment. Must be a URL-friendly string according to [RFC 3968, section 2.3](https://www.rfc-editor.org/rfc/rfc3986#section-2.3)
+	Name string `json:"name"`
+
+	// SortOrder Defines where in the list of environments to place this environment. The list uses an ascending sort, so lower numbers are shown first. You can change this value later.
+	SortOrder *int `json:"sortOrder,omitempty"`
+
+	// Type The [type of environment](https://docs.getunleash.io/reference/environments#environment-types) you would like to create. Unleash officially recognizes the following values:
+	// - `development`
+	// - `test`
+	// - `preproduction`
+	// - `production`
+	//
+	// If you pass a string that is not one of the recognized values, Unleash will accept it, but it will carry no special semantics.
+	Type string `json:"type"`
+}
+
 // CreateFeatureNamingPatternSchema Create a feature naming pattern
 type CreateFeatureNamingPatternSchema struct {
 	// Description A description of the pattern in a human-readable format. Will be shown to users when they create a new feature flag.
@@ -1525,6 +1567,39 @@ type CreateInvitedUserSchema struct {
 	Username *string `json:"username,omitempty"`
 }
 
+// CreatePatSchema Describes the properties required to create a [Personal Access Token](https://docs.getunleash.io/how-to/how-to-create-personal-access-tokens).
+type CreatePatSchema struct {
+	// Description The description of the token.
+	Description string `json:"description"`
+
+	// ExpiresAt The token's expiration date.
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// CreateProjectSchema Data used to create a new [project](https://docs.getunleash.io/reference/projects).
+type CreateProjectSchema struct {
+	// DefaultStickiness A default stickiness for the project affecting the default stickiness value for variants and Gradual Rollout strategy
+	DefaultStickiness *string `json:"defaultStickiness,omitempty"`
+
+	// Description The project's description.
+	Description *string `json:"description"`
+
+	// Environments A list of environments that should be enabled for this project. When this property is missing, Unleash will default to enabling all non-deprecated environments for the project.
+	Environments *[]string `json:"environments,omitempty"`
+
+	// Id The project's identifier.
+	Id string `json:"id"`
+
+	// Mode A mode of the project affecting what actions are possible in this project
+	Mode *CreateProjectSchemaMode `json:"mode,omitempty"`
+
+	// Name The project's name.
+	Name string `json:"name"`
+}
+
+// CreateProjectSchemaMode A mode of the project affecting what actions are possible in this project
+type CreateProjectSchemaMode string
+
 // CreateStrategySchema The data required to create a strategy type. Refer to the docs on [custom strategy types](https://docs.getunleash.io/reference/custom-activation-strategies) for more information.
 type CreateStrategySchema struct {
 	// Deprecated Whether the strategy type is deprecated or not. Defaults to `false`.
@@ -2737,6 +2812,9 @@ type PatSchema struct {
 	// CreatedAt When the token was created. (This property is set by Unleash when the token is created and cannot be set manually: if you provide a value when creating a PAT, Unleash will ignore it.)
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
+	// Description The description of the token.
+	Description *string `json:"description,omitempty"`
+
 	// ExpiresAt The token's expiration date.
 	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
 
@@ -3047,6 +3125,30 @@ type ProfileSchema struct {
 	RootRole RoleSchema `json:"rootRole"`
 }
 
+// ProjectCreatedSchema Details about the newly created project.
+type ProjectCreatedSchema struct {
+	// DefaultStickiness A default stickiness for the project affecting the default stickiness value for variants and Gradual Rollout strategy
+	DefaultStickiness *string `json:"defaultStickiness,omitempty"`
+
+	// Description The project's description.
+	Description *string `json:"description"`
+
+	// Environments The environments enabled for the project.
+	Environments *[]string `json:"environments,omitempty"`
+
+	// Id The project's identifier.
+	Id string `json:"id"`
+
+	// Mode A mode of the project affecting what actions are possible in this project
+	Mode *ProjectCreatedSchemaMode `json:"mode,omitempty"`
+
+	// Name The project's name.
+	Name string `json:"name"`
+}
+
+// ProjectCreatedSchemaMode A mode of the project affecting what actions are possible in this project
+type ProjectCreatedSchemaMode string
+
 // ProjectDoraMetricsSchema A projects dora metrics
 type ProjectDoraMetricsSchema struct {
 	// Features An array of objects containing feature toggle name and timeToProduction values. The measurement unit of timeToProduction is days.
@@ -3845,6 +3947,15 @@ type UpdateContextFieldSchema struct {
 	Stickiness *bool `json:"stickiness,omitempty"`
 }
 
+// UpdateEnvironmentSchema Data used to update an [environment](https://docs.getunleash.io/reference/environments).
+type UpdateEnvironmentSchema struct {
+	// SortOrder Changes the sort order of this environment.
+	SortOrder *int `json:"sortOrder,omitempty"`
+
+	// Type Updates the type of environment (i.e. development or production).
+	Type *string `json:"type,omitempty"`
+}
+
 // UpdateFeatureSchema Data used for updating a feature toggle
 type UpdateFeatureSchema struct {
 	// Archived If `true` the feature toggle will be moved to the [archive](https://docs.getunleash.io/reference/archived-toggles) with a property `archivedAt` set to current time
@@ -3905,6 +4016,24 @@ type UpdateFeatureTypeLifetimeSchema struct {
 	LifetimeDays *int `json:"lifetimeDays"`
 }
 
+// UpdateProjectSchema Data used to update a [project](https://docs.getunleash.io/reference/projects)
+type UpdateProjectSchema struct {
+	// DefaultStickiness A default stickiness for the project affecting the default stickiness value for variants and Gradual Rollout strategy
+	DefaultStickiness *string `json:"defaultStickiness,omitempty"`
+
+	// Description A new description for the project
+	Description *string `json:"description,omitempty"`
+
+	// Mode A mode of the project affecting what actions are possible in this project
+	Mode *UpdateProjectSchemaMode `json:"mode,omitempty"`
+
+	// Name The new name of the project
+	Name string `json:"name"`
+}
+
+// UpdateProjectSchemaMode A mode of the project affecting what actions are possible in this project
+type UpdateProjectSchemaMode string
+
 // UpdateStrategySchema The data required to update a strategy type.
 type UpdateStrategySchema struct {
 	// Description A description of the strategy type.
@@ -3924,6 +4053,9 @@ type UpdateStrategySchema struct {
 		// Type The [type of the parameter](https://docs.getunleash.io/reference/custom-activation-strategies#parameter-types)
 		Type UpdateStrategySchemaParametersType `json:"type"`
 	} `json:"parameters"`
+
+	// Title The title of the strategy
+	Title *string `json:"title,omitempty"`
 }
 
 // UpdateStrategySchemaParametersType The [type of the parameter](https://docs.getunleash.io/reference/custom-activation-strategies#parameter-types)
@@ -4343,9 +4475,15 @@ type ValidateJSONRequestBody = NameSchema
 // UpdateContextFieldJSONRequestBody defines body for UpdateContextField for application/json ContentType.
 type UpdateContextFieldJSONRequestBody = UpdateContextFieldSchema
 
+// CreateEnvironmentJSONRequestBody defines body for CreateEnvironment for application/json ContentType.
+type CreateEnvironmentJSONRequestBody = CreateEnvironmentSchema
+
 // UpdateSortOrderJSONRequestBody defines body for UpdateSortOrder for application/json ContentType.
 type UpdateSortOrderJSONRequestBody = SortOrderSchema
 
+// UpdateEnvironmentJSONRequestBody defines body for UpdateEnvironment for application/json ContentType.
+type UpdateEnvironmentJSONRequestBody = UpdateEnvironmentSchema
+
 // SearchEventsJSONRequestBody defines body for SearchEvents for application/json ContentType.
 type SearchEventsJSONRequestBody = SearchEventsSchema
 
@@ -4394,6 +4532,12 @@ type GetPlaygroundJSONRequestBody = PlaygroundRequestSchema
 // GetAdvancedPlaygroundJSONRequestBody defines body for GetAdvancedPlayground for application/json ContentType.
 type GetAdvancedPlaygroundJSONRequestBody = AdvancedPlaygroundRequestSchema
 
+// CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
+type CreateProjectJSONRequestBody = CreateProjectSchema
+
+// UpdateProjectJSONRequestBody defines body for UpdateProject for application/json ContentType.
+type UpdateProjectJSONRequestBody = UpdateProjectSchema
+
 // CreateProjectApiTokenJSONRequestBody defines body for CreateProjectApiToken for application/json ContentType.
 type CreateProjectApiTokenJSONRequestBody = CreateApiTokenSchema
 
@@ -4524,7 +4668,7 @@ type ChangeUserPasswordJSONRequestBody = PasswordSchema
 type ChangeMyPasswordJSONRequestBody = PasswordSchema
 
 // CreatePatJSONRequestBody defines body for CreatePat for application/json ContentType.
-type CreatePatJSONRequestBody = PatSchema
+type CreatePatJSONRequestBody = CreatePatSchema
 
 // RegisterClientMetricsJSONRequestBody defines body for RegisterClientMetrics for application/json ContentType.
 type RegisterClientMetricsJSONRequestBody = ClientMetricsSchema
@@ -6028,6 +6172,11 @@ type ClientInterface interface {
 	// GetAllEnvironments request
 	GetAllEnvironments(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateEnvironmentWithBody request with any body
+	CreateEnvironmentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateEnvironment(ctx context.Context, body CreateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetProjectEnvironments request
 	GetProjectEnvironments(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -6036,6 +6185,14 @@ type ClientInterface interface {
 
 	UpdateSortOrder(ctx context.Context, body UpdateSortOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateEnvironmentWithBody request with any body
+	UpdateEnvironmentWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateEnvironment(ctx context.Context, name string, body UpdateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveEnvironment request
+	RemoveEnvironment(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetEnvironment request
 	GetEnvironment(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -6170,9 +6327,22 @@ type ClientInterface interface {
 	// GetProjects request
 	GetProjects(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateProjectWithBody request with any body
+	CreateProjectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateProject(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteProject request
+	DeleteProject(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetDeprecatedProjectOverview request
 	GetDeprecatedProjectOverview(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateProjectWithBody request with any body
+	UpdateProjectWithBody(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateProject(ctx context.Context, projectId string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetProjectApiTokens request
 	GetProjectApiTokens(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -7034,6 +7204,30 @@ func (c *Client) GetAllEnvironments(ctx context.Context, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
+func (c *Client) CreateEnvironmentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEnvironmentRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateEnvironment(ctx context.Context, body CreateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEnvironmentRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetProjectEnvironments(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetProjectEnvironmentsRequest(c.Server, projectId)
 	if err != nil {
@@ -7070,6 +7264,42 @@ func (c *Client) UpdateSortOrder(ctx context.Context, body UpdateSortOrderJSONRe
 	return c.Client.Do(req)
 }
 
+func (c *Client) UpdateEnvironmentWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateEnvironmentRequestWithBody(c.Server, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateEnvironment(ctx context.Context, name string, body UpdateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateEnvironmentRequest(c.Server, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveEnvironment(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveEnvironmentRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetEnvironment(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetEnvironmentRequest(c.Server, name)
 	if err != nil {
@@ -7670,6 +7900,42 @@ func (c *Client) GetProjects(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
+func (c *Client) CreateProjectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateProject(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteProject(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectRequest(c.Server, projectId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetDeprecatedProjectOverview(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetDeprecatedProjectOverviewRequest(c.Server, projectId)
 	if err != nil {
@@ -7682,6 +7948,30 @@ func (c *Client) GetDeprecatedProjectOverview(ctx context.Context, projectId str
 	return c.Client.Do(req)
 }
 
+func (c *Client) UpdateProjectWithBody(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectRequestWithBody(c.Server, projectId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateProject(ctx context.Context, projectId string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectRequest(c.Server, projectId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetProjectApiTokens(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetProjectApiTokensRequest(c.Server, projectId)
 	if err != nil {
@@ -10642,6 +10932,46 @@ func NewGetAllEnvironmentsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewCreateEnvironmentRequest calls the generic CreateEnvironment builder with application/json body
+func NewCreateEnvironmentRequest(server string, body CreateEnvironmentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateEnvironmentRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateEnvironmentRequestWithBody generates requests for CreateEnvironment with any type of body
+func NewCreateEnvironmentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/environments")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewGetProjectEnvironmentsRequest generates requests for GetProjectEnvironments
 func NewGetProjectEnvironmentsRequest(server string, projectId string) (*http.Request, error) {
 	var err error
@@ -10716,6 +11046,87 @@ func NewUpdateSortOrderRequestWithBody(server string, contentType string, body i
 	return req, nil
 }
 
+// NewUpdateEnvironmentRequest calls the generic UpdateEnvironment builder with application/json body
+func NewUpdateEnvironmentRequest(server string, name string, body UpdateEnvironmentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateEnvironmentRequestWithBody(server, name, "application/json", bodyReader)
+}
+
+// NewUpdateEnvironmentRequestWithBody generates requests for UpdateEnvironment with any type of body
+func NewUpdateEnvironmentRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/environments/update/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveEnvironmentRequest generates requests for RemoveEnvironment
+func NewRemoveEnvironmentRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/environments/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetEnvironmentRequest generates requests for GetEnvironment
 func NewGetEnvironmentRequest(server string, name string) (*http.Request, error) {
 	var err error
@@ -11983,6 +12394,80 @@ func NewGetProjectsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewCreateProjectRequest calls the generic CreateProject builder with application/json body
+func NewCreateProjectRequest(server string, body CreateProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateProjectRequestWithBody generates requests for CreateProject with any type of body
+func NewCreateProjectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProjectRequest generates requests for DeleteProject
+func NewDeleteProjectRequest(server string, projectId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetDeprecatedProjectOverviewRequest generates requests for GetDeprecatedProjectOverview
 func NewGetDeprecatedProjectOverviewRequest(server string, projectId string) (*http.Request, error) {
 	var err error
@@ -12017,6 +12502,53 @@ func NewGetDeprecatedProjectOverviewRequest(server string, projectId string) (*h
 	return req, nil
 }
 
+// NewUpdateProjectRequest calls the generic UpdateProject builder with application/json body
+func NewUpdateProjectRequest(server string, projectId string, body UpdateProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectRequestWithBody(server, projectId, "application/json", bodyReader)
+}
+
+// NewUpdateProjectRequestWithBody generates requests for UpdateProject with any type of body
+func NewUpdateProjectRequestWithBody(server string, projectId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectId", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/admin/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewGetProjectApiTokensRequest generates requests for GetProjectApiTokens
 func NewGetProjectApiTokensRequest(server string, projectId string) (*http.Request, error) {
 	var err error
@@ -17302,6 +17834,11 @@ type ClientWithResponsesInterface interface {
 	// GetAllEnvironmentsWithResponse request
 	GetAllEnvironmentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllEnvironmentsResponse, error)
 
+	// CreateEnvironmentWithBodyWithResponse request with any body
+	CreateEnvironmentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnvironmentResponse, error)
+
+	CreateEnvironmentWithResponse(ctx context.Context, body CreateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnvironmentResponse, error)
+
 	// GetProjectEnvironmentsWithResponse request
 	GetProjectEnvironmentsWithResponse(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*GetProjectEnvironmentsResponse, error)
 
@@ -17310,6 +17847,14 @@ type ClientWithResponsesInterface interface {
 
 	UpdateSortOrderWithResponse(ctx context.Context, body UpdateSortOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSortOrderResponse, error)
 
+	// UpdateEnvironmentWithBodyWithResponse request with any body
+	UpdateEnvironmentWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEnvironmentResponse, error)
+
+	UpdateEnvironmentWithResponse(ctx context.Context, name string, body UpdateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEnvironmentResponse, error)
+
+	// RemoveEnvironmentWithResponse request
+	RemoveEnvironmentWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*RemoveEnvironmentResponse, error)
+
 	// GetEnvironmentWithResponse request
 	GetEnvironmentWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetEnvironmentResponse, error)
 
@@ -17444,9 +17989,22 @@ type ClientWithResponsesInterface interface {
 	// GetProjectsWithResponse request
 	GetProjectsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetProjectsResponse, error)
 
+	// CreateProjectWithBodyWithResponse request with any body
+	CreateProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error)
+
+	CreateProjectWithResponse(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error)
+
+	// DeleteProjectWithResponse request
+	DeleteProjectWithResponse(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error)
+
 	// GetDeprecatedProjectOverviewWithResponse request
 	GetDeprecatedProjectOverviewWithResponse(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*GetDeprecatedProjectOverviewResponse, error)
 
+	// UpdateProjectWithBodyWithResponse request with any body
+	UpdateProjectWithBodyWithResponse(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error)
+
+	UpdateProjectWithResponse(ctx context.Context, projectId string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error)
+
 	// GetProjectApiTokensWithResponse request
 	GetProjectApiTokensWithResponse(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*GetProjectApiTokensResponse, error)
 
@@ -18953,6 +19511,68 @@ func (r GetAllEnvironmentsResponse) StatusCode() int {
 	return 0
 }
 
+type CreateEnvironmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *EnvironmentSchema
+	JSON400      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON401 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON403 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON409 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateEnvironmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateEnvironmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetProjectEnvironmentsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -19056,11 +19676,21 @@ func (r UpdateSortOrderResponse) StatusCode() int {
 	return 0
 }
 
-type GetEnvironmentResponse struct {
+type UpdateEnvironmentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *EnvironmentSchema
-	JSON401      *struct {
+	JSON400      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON401 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -19093,7 +19723,7 @@ type GetEnvironmentResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetEnvironmentResponse) Status() string {
+func (r UpdateEnvironmentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -19101,16 +19731,78 @@ func (r GetEnvironmentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetEnvironmentResponse) StatusCode() int {
+func (r UpdateEnvironmentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ToggleEnvironmentOffResponse struct {
+type RemoveEnvironmentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON400      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON401 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON403 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON404 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveEnvironmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveEnvironmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEnvironmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EnvironmentSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -19144,7 +19836,7 @@ type ToggleEnvironmentOffResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r ToggleEnvironmentOffResponse) Status() string {
+func (r GetEnvironmentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -19152,14 +19844,65 @@ func (r ToggleEnvironmentOffResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ToggleEnvironmentOffResponse) StatusCode() int {
+func (r GetEnvironmentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ToggleEnvironmentOnResponse struct {
+type ToggleEnvironmentOffResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON403 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON404 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ToggleEnvironmentOffResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ToggleEnvironmentOffResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ToggleEnvironmentOnResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON401      *struct {
@@ -20515,21 +21258,11 @@ func (r GetProjectsResponse) StatusCode() int {
 	return 0
 }
 
-type GetDeprecatedProjectOverviewResponse struct {
+type CreateProjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *DeprecatedProjectOverviewSchema
-	JSON401      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON403 *struct {
+	JSON201      *ProjectCreatedSchema
+	JSON400      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -20539,7 +21272,7 @@ type GetDeprecatedProjectOverviewResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON404 *struct {
+	JSON401 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -20549,29 +21282,7 @@ type GetDeprecatedProjectOverviewResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-}
-
-// Status returns HTTPResponse.Status
-func (r GetDeprecatedProjectOverviewResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDeprecatedProjectOverviewResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
-
-type GetProjectApiTokensResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ApiTokensSchema
-	JSON401      *struct {
+	JSON403 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -20581,7 +21292,7 @@ type GetProjectApiTokensResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON403 *struct {
+	JSON409 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -20591,7 +21302,7 @@ type GetProjectApiTokensResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON404 *struct {
+	JSON415 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -20604,7 +21315,7 @@ type GetProjectApiTokensResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetProjectApiTokensResponse) Status() string {
+func (r CreateProjectResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -20612,28 +21323,17 @@ func (r GetProjectApiTokensResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectApiTokensResponse) StatusCode() int {
+func (r CreateProjectResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateProjectApiTokenResponse struct {
+type DeleteProjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *ApiTokenSchema
-	JSON400      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON401 *struct {
+	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -20666,7 +21366,7 @@ type CreateProjectApiTokenResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateProjectApiTokenResponse) Status() string {
+func (r DeleteProjectResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -20674,27 +21374,18 @@ func (r CreateProjectApiTokenResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateProjectApiTokenResponse) StatusCode() int {
+func (r DeleteProjectResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteProjectApiTokenResponse struct {
+type GetDeprecatedProjectOverviewResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON401 *struct {
+	JSON200      *DeprecatedProjectOverviewSchema
+	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -20727,7 +21418,7 @@ type DeleteProjectApiTokenResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteProjectApiTokenResponse) Status() string {
+func (r GetDeprecatedProjectOverviewResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -20735,14 +21426,14 @@ func (r DeleteProjectApiTokenResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteProjectApiTokenResponse) StatusCode() int {
+func (r GetDeprecatedProjectOverviewResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ArchiveFeaturesResponse struct {
+type UpdateProjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *struct {
@@ -20775,59 +21466,7 @@ type ArchiveFeaturesResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON415 *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-}
-
-// Status returns HTTPResponse.Status
-func (r ArchiveFeaturesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r ArchiveFeaturesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
-
-type ValidateArchiveFeaturesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ValidateArchiveFeaturesSchema
-	JSON400      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON401 *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON403 *struct {
+	JSON404 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -20850,7 +21489,7 @@ type ValidateArchiveFeaturesResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r ValidateArchiveFeaturesResponse) Status() string {
+func (r UpdateProjectResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -20858,27 +21497,18 @@ func (r ValidateArchiveFeaturesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ValidateArchiveFeaturesResponse) StatusCode() int {
+func (r UpdateProjectResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type BulkToggleFeaturesEnvironmentOffResponse struct {
+type GetProjectApiTokensResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON401 *struct {
+	JSON200      *ApiTokensSchema
+	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -20905,33 +21535,13 @@ type BulkToggleFeaturesEnvironmentOffResponse struct {
 		// Message A description of what went wrong.
 		Message *string `json:"message,omitempty"`
 
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON413 *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON415 *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r BulkToggleFeaturesEnvironmentOffResponse) Status() string {
+func (r GetProjectApiTokensResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -20939,16 +21549,17 @@ func (r BulkToggleFeaturesEnvironmentOffResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r BulkToggleFeaturesEnvironmentOffResponse) StatusCode() int {
+func (r GetProjectApiTokensResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type BulkToggleFeaturesEnvironmentOnResponse struct {
+type CreateProjectApiTokenResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON201      *ApiTokenSchema
 	JSON400      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -20986,33 +21597,13 @@ type BulkToggleFeaturesEnvironmentOnResponse struct {
 		// Message A description of what went wrong.
 		Message *string `json:"message,omitempty"`
 
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON413 *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON415 *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r BulkToggleFeaturesEnvironmentOnResponse) Status() string {
+func (r CreateProjectApiTokenResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21020,14 +21611,14 @@ func (r BulkToggleFeaturesEnvironmentOnResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r BulkToggleFeaturesEnvironmentOnResponse) StatusCode() int {
+func (r CreateProjectApiTokenResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteFeaturesResponse struct {
+type DeleteProjectApiTokenResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *struct {
@@ -21057,13 +21648,23 @@ type DeleteFeaturesResponse struct {
 		// Message A description of what went wrong.
 		Message *string `json:"message,omitempty"`
 
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON404 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteFeaturesResponse) Status() string {
+func (r DeleteProjectApiTokenResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21071,18 +21672,27 @@ func (r DeleteFeaturesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteFeaturesResponse) StatusCode() int {
+func (r DeleteProjectApiTokenResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CheckDependenciesExistResponse struct {
+type ArchiveFeaturesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *DependenciesExistSchema
-	JSON401      *struct {
+	JSON400      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON401 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21099,13 +21709,23 @@ type CheckDependenciesExistResponse struct {
 		// Message A description of what went wrong.
 		Message *string `json:"message,omitempty"`
 
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON415 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r CheckDependenciesExistResponse) Status() string {
+func (r ArchiveFeaturesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21113,18 +21733,28 @@ func (r CheckDependenciesExistResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CheckDependenciesExistResponse) StatusCode() int {
+func (r ArchiveFeaturesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetProjectDoraResponse struct {
+type ValidateArchiveFeaturesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ProjectDoraMetricsSchema
-	JSON401      *struct {
+	JSON200      *ValidateArchiveFeaturesSchema
+	JSON400      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON401 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21144,7 +21774,7 @@ type GetProjectDoraResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON404 *struct {
+	JSON415 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21157,7 +21787,7 @@ type GetProjectDoraResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetProjectDoraResponse) Status() string {
+func (r ValidateArchiveFeaturesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21165,17 +21795,27 @@ func (r GetProjectDoraResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectDoraResponse) StatusCode() int {
+func (r ValidateArchiveFeaturesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AddEnvironmentToProjectResponse struct {
+type BulkToggleFeaturesEnvironmentOffResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON401      *struct {
+	JSON400      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON401 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21195,7 +21835,27 @@ type AddEnvironmentToProjectResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON409 *struct {
+	JSON404 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON413 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON415 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21208,7 +21868,7 @@ type AddEnvironmentToProjectResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r AddEnvironmentToProjectResponse) Status() string {
+func (r BulkToggleFeaturesEnvironmentOffResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21216,14 +21876,14 @@ func (r AddEnvironmentToProjectResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AddEnvironmentToProjectResponse) StatusCode() int {
+func (r BulkToggleFeaturesEnvironmentOffResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type RemoveEnvironmentFromProjectResponse struct {
+type BulkToggleFeaturesEnvironmentOnResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *struct {
@@ -21256,29 +21916,27 @@ type RemoveEnvironmentFromProjectResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-}
+	JSON404 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
 
-// Status returns HTTPResponse.Status
-func (r RemoveEnvironmentFromProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RemoveEnvironmentFromProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
 	}
-	return 0
-}
+	JSON413 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
 
-type AddDefaultStrategyToProjectEnvironmentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CreateFeatureStrategySchema
-	JSON400      *struct {
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON415 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21291,7 +21949,7 @@ type AddDefaultStrategyToProjectEnvironmentResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r AddDefaultStrategyToProjectEnvironmentResponse) Status() string {
+func (r BulkToggleFeaturesEnvironmentOnResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21299,17 +21957,17 @@ func (r AddDefaultStrategyToProjectEnvironmentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AddDefaultStrategyToProjectEnvironmentResponse) StatusCode() int {
+func (r BulkToggleFeaturesEnvironmentOnResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type RemoveFavoriteProjectResponse struct {
+type DeleteFeaturesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON401      *struct {
+	JSON400      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21319,7 +21977,17 @@ type RemoveFavoriteProjectResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON404 *struct {
+	JSON401 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON403 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21332,7 +22000,7 @@ type RemoveFavoriteProjectResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r RemoveFavoriteProjectResponse) Status() string {
+func (r DeleteFeaturesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21340,16 +22008,17 @@ func (r RemoveFavoriteProjectResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r RemoveFavoriteProjectResponse) StatusCode() int {
+func (r DeleteFeaturesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AddFavoriteProjectResponse struct {
+type CheckDependenciesExistResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *DependenciesExistSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -21360,7 +22029,7 @@ type AddFavoriteProjectResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON404 *struct {
+	JSON403 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21373,7 +22042,7 @@ type AddFavoriteProjectResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r AddFavoriteProjectResponse) Status() string {
+func (r CheckDependenciesExistResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21381,18 +22050,18 @@ func (r AddFavoriteProjectResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AddFavoriteProjectResponse) StatusCode() int {
+func (r CheckDependenciesExistResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetFeaturesResponse struct {
+type GetProjectDoraResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FeaturesSchema
-	JSON400      *struct {
+	JSON200      *ProjectDoraMetricsSchema
+	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21402,7 +22071,7 @@ type GetFeaturesResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON401 *struct {
+	JSON403 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21412,7 +22081,7 @@ type GetFeaturesResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON403 *struct {
+	JSON404 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21425,7 +22094,7 @@ type GetFeaturesResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetFeaturesResponse) Status() string {
+func (r GetProjectDoraResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21433,17 +22102,16 @@ func (r GetFeaturesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetFeaturesResponse) StatusCode() int {
+func (r GetProjectDoraResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateFeatureResponse struct {
+type AddEnvironmentToProjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FeatureSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -21464,17 +22132,7 @@ type CreateFeatureResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON404 *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON415 *struct {
+	JSON409 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21487,7 +22145,7 @@ type CreateFeatureResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateFeatureResponse) Status() string {
+func (r AddEnvironmentToProjectResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21495,17 +22153,17 @@ func (r CreateFeatureResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateFeatureResponse) StatusCode() int {
+func (r AddEnvironmentToProjectResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ArchiveFeatureResponse struct {
+type RemoveEnvironmentFromProjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON401      *struct {
+	JSON400      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21515,7 +22173,7 @@ type ArchiveFeatureResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON403 *struct {
+	JSON401 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21525,7 +22183,7 @@ type ArchiveFeatureResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON404 *struct {
+	JSON403 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21538,7 +22196,7 @@ type ArchiveFeatureResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r ArchiveFeatureResponse) Status() string {
+func (r RemoveEnvironmentFromProjectResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21546,18 +22204,18 @@ func (r ArchiveFeatureResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ArchiveFeatureResponse) StatusCode() int {
+func (r RemoveEnvironmentFromProjectResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetFeatureResponse struct {
+type AddDefaultStrategyToProjectEnvironmentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FeatureSchema
-	JSON401      *struct {
+	JSON200      *CreateFeatureStrategySchema
+	JSON400      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21567,7 +22225,28 @@ type GetFeatureResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON403 *struct {
+}
+
+// Status returns HTTPResponse.Status
+func (r AddDefaultStrategyToProjectEnvironmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddDefaultStrategyToProjectEnvironmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveFavoriteProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21590,7 +22269,7 @@ type GetFeatureResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetFeatureResponse) Status() string {
+func (r RemoveFavoriteProjectResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21598,17 +22277,16 @@ func (r GetFeatureResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetFeatureResponse) StatusCode() int {
+func (r RemoveFavoriteProjectResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PatchFeatureResponse struct {
+type AddFavoriteProjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FeatureSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -21619,7 +22297,7 @@ type PatchFeatureResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON403 *struct {
+	JSON404 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21629,7 +22307,29 @@ type PatchFeatureResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON404 *struct {
+}
+
+// Status returns HTTPResponse.Status
+func (r AddFavoriteProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddFavoriteProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFeaturesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FeaturesSchema
+	JSON400      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21639,7 +22339,17 @@ type PatchFeatureResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON415 *struct {
+	JSON401 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON403 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -21652,7 +22362,7 @@ type PatchFeatureResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r PatchFeatureResponse) Status() string {
+func (r GetFeaturesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21660,14 +22370,14 @@ func (r PatchFeatureResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PatchFeatureResponse) StatusCode() int {
+func (r GetFeaturesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateFeatureResponse struct {
+type CreateFeatureResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *FeatureSchema
@@ -21714,7 +22424,7 @@ type UpdateFeatureResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateFeatureResponse) Status() string {
+func (r CreateFeatureResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21722,17 +22432,16 @@ func (r UpdateFeatureResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateFeatureResponse) StatusCode() int {
+func (r CreateFeatureResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CloneFeatureResponse struct {
+type ArchiveFeatureResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FeatureSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -21760,23 +22469,13 @@ type CloneFeatureResponse struct {
 		// Message A description of what went wrong.
 		Message *string `json:"message,omitempty"`
 
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON415 *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r CloneFeatureResponse) Status() string {
+func (r ArchiveFeatureResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21784,16 +22483,17 @@ func (r CloneFeatureResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CloneFeatureResponse) StatusCode() int {
+func (r ArchiveFeatureResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteFeatureDependenciesResponse struct {
+type GetFeatureResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *FeatureSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -21827,7 +22527,7 @@ type DeleteFeatureDependenciesResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteFeatureDependenciesResponse) Status() string {
+func (r GetFeatureResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21835,16 +22535,17 @@ func (r DeleteFeatureDependenciesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteFeatureDependenciesResponse) StatusCode() int {
+func (r GetFeatureResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AddFeatureDependencyResponse struct {
+type PatchFeatureResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *FeatureSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -21872,13 +22573,23 @@ type AddFeatureDependencyResponse struct {
 		// Message A description of what went wrong.
 		Message *string `json:"message,omitempty"`
 
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON415 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r AddFeatureDependencyResponse) Status() string {
+func (r PatchFeatureResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21886,16 +22597,17 @@ func (r AddFeatureDependencyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AddFeatureDependencyResponse) StatusCode() int {
+func (r PatchFeatureResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteFeatureDependencyResponse struct {
+type UpdateFeatureResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *FeatureSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -21923,13 +22635,23 @@ type DeleteFeatureDependencyResponse struct {
 		// Message A description of what went wrong.
 		Message *string `json:"message,omitempty"`
 
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON415 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteFeatureDependencyResponse) Status() string {
+func (r UpdateFeatureResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21937,17 +22659,17 @@ func (r DeleteFeatureDependencyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteFeatureDependencyResponse) StatusCode() int {
+func (r UpdateFeatureResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetFeatureEnvironmentResponse struct {
+type CloneFeatureResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FeatureEnvironmentSchema
+	JSON200      *FeatureSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -21975,13 +22697,23 @@ type GetFeatureEnvironmentResponse struct {
 		// Message A description of what went wrong.
 		Message *string `json:"message,omitempty"`
 
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON415 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r GetFeatureEnvironmentResponse) Status() string {
+func (r CloneFeatureResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21989,28 +22721,17 @@ func (r GetFeatureEnvironmentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetFeatureEnvironmentResponse) StatusCode() int {
+func (r CloneFeatureResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ToggleFeatureEnvironmentOffResponse struct {
+type DeleteFeatureDependenciesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FeatureSchema
-	JSON400      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON401 *struct {
+	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -22043,7 +22764,7 @@ type ToggleFeatureEnvironmentOffResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r ToggleFeatureEnvironmentOffResponse) Status() string {
+func (r DeleteFeatureDependenciesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -22051,28 +22772,17 @@ func (r ToggleFeatureEnvironmentOffResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ToggleFeatureEnvironmentOffResponse) StatusCode() int {
+func (r DeleteFeatureDependenciesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ToggleFeatureEnvironmentOnResponse struct {
+type AddFeatureDependencyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FeatureSchema
-	JSON400      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON401 *struct {
+	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -22105,7 +22815,7 @@ type ToggleFeatureEnvironmentOnResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r ToggleFeatureEnvironmentOnResponse) Status() string {
+func (r AddFeatureDependencyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -22113,17 +22823,16 @@ func (r ToggleFeatureEnvironmentOnResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ToggleFeatureEnvironmentOnResponse) StatusCode() int {
+func (r AddFeatureDependencyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetFeatureStrategiesResponse struct {
+type DeleteFeatureDependencyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FeatureStrategiesSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -22157,7 +22866,7 @@ type GetFeatureStrategiesResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetFeatureStrategiesResponse) Status() string {
+func (r DeleteFeatureDependencyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -22165,17 +22874,17 @@ func (r GetFeatureStrategiesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetFeatureStrategiesResponse) StatusCode() int {
+func (r DeleteFeatureDependencyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AddFeatureStrategyResponse struct {
+type GetFeatureEnvironmentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FeatureStrategySchema
+	JSON200      *FeatureEnvironmentSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -22209,7 +22918,7 @@ type AddFeatureStrategyResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r AddFeatureStrategyResponse) Status() string {
+func (r GetFeatureEnvironmentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -22217,16 +22926,17 @@ func (r AddFeatureStrategyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AddFeatureStrategyResponse) StatusCode() int {
+func (r GetFeatureEnvironmentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SetStrategySortOrderResponse struct {
+type ToggleFeatureEnvironmentOffResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *FeatureSchema
 	JSON400      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -22254,13 +22964,23 @@ type SetStrategySortOrderResponse struct {
 		// Message A description of what went wrong.
 		Message *string `json:"message,omitempty"`
 
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON404 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r SetStrategySortOrderResponse) Status() string {
+func (r ToggleFeatureEnvironmentOffResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -22268,17 +22988,28 @@ func (r SetStrategySortOrderResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SetStrategySortOrderResponse) StatusCode() int {
+func (r ToggleFeatureEnvironmentOffResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteFeatureStrategyResponse struct {
+type ToggleFeatureEnvironmentOnResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON401      *struct {
+	JSON200      *FeatureSchema
+	JSON400      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON401 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -22311,7 +23042,7 @@ type DeleteFeatureStrategyResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteFeatureStrategyResponse) Status() string {
+func (r ToggleFeatureEnvironmentOnResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -22319,17 +23050,17 @@ func (r DeleteFeatureStrategyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteFeatureStrategyResponse) StatusCode() int {
+func (r ToggleFeatureEnvironmentOnResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetFeatureStrategyResponse struct {
+type GetFeatureStrategiesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FeatureStrategySchema
+	JSON200      *FeatureStrategiesSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -22363,7 +23094,7 @@ type GetFeatureStrategyResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetFeatureStrategyResponse) Status() string {
+func (r GetFeatureStrategiesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -22371,28 +23102,18 @@ func (r GetFeatureStrategyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetFeatureStrategyResponse) StatusCode() int {
+func (r GetFeatureStrategiesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PatchFeatureStrategyResponse struct {
+type AddFeatureStrategyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *FeatureStrategySchema
-	JSON400      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON401 *struct {
+	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -22419,23 +23140,13 @@ type PatchFeatureStrategyResponse struct {
 		// Message A description of what went wrong.
 		Message *string `json:"message,omitempty"`
 
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON415 *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r PatchFeatureStrategyResponse) Status() string {
+func (r AddFeatureStrategyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -22443,17 +23154,16 @@ func (r PatchFeatureStrategyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PatchFeatureStrategyResponse) StatusCode() int {
+func (r AddFeatureStrategyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateFeatureStrategyResponse struct {
+type SetStrategySortOrderResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FeatureStrategySchema
 	JSON400      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -22484,7 +23194,28 @@ type UpdateFeatureStrategyResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON404 *struct {
+}
+
+// Status returns HTTPResponse.Status
+func (r SetStrategySortOrderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetStrategySortOrderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteFeatureStrategyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -22494,7 +23225,17 @@ type UpdateFeatureStrategyResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON415 *struct {
+	JSON403 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON404 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -22507,7 +23248,7 @@ type UpdateFeatureStrategyResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateFeatureStrategyResponse) Status() string {
+func (r DeleteFeatureStrategyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -22515,17 +23256,17 @@ func (r UpdateFeatureStrategyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateFeatureStrategyResponse) StatusCode() int {
+func (r DeleteFeatureStrategyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetEnvironmentFeatureVariantsResponse struct {
+type GetFeatureStrategyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FeatureVariantsSchema
+	JSON200      *FeatureStrategySchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -22559,7 +23300,7 @@ type GetEnvironmentFeatureVariantsResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetEnvironmentFeatureVariantsResponse) Status() string {
+func (r GetFeatureStrategyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -22567,17 +23308,17 @@ func (r GetEnvironmentFeatureVariantsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetEnvironmentFeatureVariantsResponse) StatusCode() int {
+func (r GetFeatureStrategyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PatchEnvironmentsFeatureVariantsResponse struct {
+type PatchFeatureStrategyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FeatureVariantsSchema
+	JSON200      *FeatureStrategySchema
 	JSON400      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -22615,13 +23356,23 @@ type PatchEnvironmentsFeatureVariantsResponse struct {
 		// Message A description of what went wrong.
 		Message *string `json:"message,omitempty"`
 
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON415 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r PatchEnvironmentsFeatureVariantsResponse) Status() string {
+func (r PatchFeatureStrategyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -22629,17 +23380,17 @@ func (r PatchEnvironmentsFeatureVariantsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PatchEnvironmentsFeatureVariantsResponse) StatusCode() int {
+func (r PatchFeatureStrategyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type OverwriteEnvironmentFeatureVariantsResponse struct {
+type UpdateFeatureStrategyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FeatureVariantsSchema
+	JSON200      *FeatureStrategySchema
 	JSON400      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -22667,37 +23418,6 @@ type OverwriteEnvironmentFeatureVariantsResponse struct {
 		// Message A description of what went wrong.
 		Message *string `json:"message,omitempty"`
 
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-}
-
-// Status returns HTTPResponse.Status
-func (r OverwriteEnvironmentFeatureVariantsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r OverwriteEnvironmentFeatureVariantsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
-
-type RemoveFavoriteFeatureResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
@@ -22711,38 +23431,7 @@ type RemoveFavoriteFeatureResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-}
-
-// Status returns HTTPResponse.Status
-func (r RemoveFavoriteFeatureResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r RemoveFavoriteFeatureResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
-
-type AddFavoriteFeatureResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON404 *struct {
+	JSON415 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -22755,7 +23444,7 @@ type AddFavoriteFeatureResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r AddFavoriteFeatureResponse) Status() string {
+func (r UpdateFeatureStrategyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -22763,17 +23452,17 @@ func (r AddFavoriteFeatureResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AddFavoriteFeatureResponse) StatusCode() int {
+func (r UpdateFeatureStrategyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ListParentOptionsResponse struct {
+type GetEnvironmentFeatureVariantsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ParentFeatureOptionsSchema
+	JSON200      *FeatureVariantsSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -22807,7 +23496,7 @@ type ListParentOptionsResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r ListParentOptionsResponse) Status() string {
+func (r GetEnvironmentFeatureVariantsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -22815,18 +23504,28 @@ func (r ListParentOptionsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListParentOptionsResponse) StatusCode() int {
+func (r GetEnvironmentFeatureVariantsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetFeatureVariantsResponse struct {
+type PatchEnvironmentsFeatureVariantsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *FeatureVariantsSchema
-	JSON401      *struct {
+	JSON400      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON401 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -22859,7 +23558,7 @@ type GetFeatureVariantsResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetFeatureVariantsResponse) Status() string {
+func (r PatchEnvironmentsFeatureVariantsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -22867,14 +23566,14 @@ func (r GetFeatureVariantsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetFeatureVariantsResponse) StatusCode() int {
+func (r PatchEnvironmentsFeatureVariantsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PatchFeatureVariantsResponse struct {
+type OverwriteEnvironmentFeatureVariantsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *FeatureVariantsSchema
@@ -22905,23 +23604,13 @@ type PatchFeatureVariantsResponse struct {
 		// Message A description of what went wrong.
 		Message *string `json:"message,omitempty"`
 
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON404 *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r PatchFeatureVariantsResponse) Status() string {
+func (r OverwriteEnvironmentFeatureVariantsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -22929,38 +23618,17 @@ func (r PatchFeatureVariantsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PatchFeatureVariantsResponse) StatusCode() int {
+func (r OverwriteEnvironmentFeatureVariantsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type OverwriteFeatureVariantsResponse struct {
+type RemoveFavoriteFeatureResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FeatureVariantsSchema
-	JSON400      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON401 *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON403 *struct {
+	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -22983,7 +23651,7 @@ type OverwriteFeatureVariantsResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r OverwriteFeatureVariantsResponse) Status() string {
+func (r RemoveFavoriteFeatureResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -22991,28 +23659,17 @@ func (r OverwriteFeatureVariantsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r OverwriteFeatureVariantsResponse) StatusCode() int {
+func (r RemoveFavoriteFeatureResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type OverwriteFeatureVariantsOnEnvironmentsResponse struct {
+type AddFavoriteFeatureResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FeatureVariantsSchema
-	JSON400      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON401 *struct {
+	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23022,7 +23679,7 @@ type OverwriteFeatureVariantsOnEnvironmentsResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON403 *struct {
+	JSON404 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23035,7 +23692,7 @@ type OverwriteFeatureVariantsOnEnvironmentsResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r OverwriteFeatureVariantsOnEnvironmentsResponse) Status() string {
+func (r AddFavoriteFeatureResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -23043,17 +23700,17 @@ func (r OverwriteFeatureVariantsOnEnvironmentsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r OverwriteFeatureVariantsOnEnvironmentsResponse) StatusCode() int {
+func (r AddFavoriteFeatureResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetProjectHealthReportResponse struct {
+type ListParentOptionsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *HealthReportSchema
+	JSON200      *ParentFeatureOptionsSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -23087,7 +23744,7 @@ type GetProjectHealthReportResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetProjectHealthReportResponse) Status() string {
+func (r ListParentOptionsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -23095,17 +23752,17 @@ func (r GetProjectHealthReportResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectHealthReportResponse) StatusCode() int {
+func (r ListParentOptionsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetProjectOverviewResponse struct {
+type GetFeatureVariantsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ProjectOverviewSchema
+	JSON200      *FeatureVariantsSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -23139,7 +23796,7 @@ type GetProjectOverviewResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetProjectOverviewResponse) Status() string {
+func (r GetFeatureVariantsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -23147,16 +23804,17 @@ func (r GetProjectOverviewResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectOverviewResponse) StatusCode() int {
+func (r GetFeatureVariantsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ReviveFeaturesResponse struct {
+type PatchFeatureVariantsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *FeatureVariantsSchema
 	JSON400      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -23184,13 +23842,23 @@ type ReviveFeaturesResponse struct {
 		// Message A description of what went wrong.
 		Message *string `json:"message,omitempty"`
 
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON404 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r ReviveFeaturesResponse) Status() string {
+func (r PatchFeatureVariantsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -23198,17 +23866,28 @@ func (r ReviveFeaturesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ReviveFeaturesResponse) StatusCode() int {
+func (r PatchFeatureVariantsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type StaleFeaturesResponse struct {
+type OverwriteFeatureVariantsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON401      *struct {
+	JSON200      *FeatureVariantsSchema
+	JSON400      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON401 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23228,7 +23907,7 @@ type StaleFeaturesResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON415 *struct {
+	JSON404 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23241,7 +23920,7 @@ type StaleFeaturesResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r StaleFeaturesResponse) Status() string {
+func (r OverwriteFeatureVariantsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -23249,27 +23928,18 @@ func (r StaleFeaturesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r StaleFeaturesResponse) StatusCode() int {
+func (r OverwriteFeatureVariantsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AddTagToFeaturesResponse struct {
+type OverwriteFeatureVariantsOnEnvironmentsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON401      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON403 *struct {
+	JSON200      *FeatureVariantsSchema
+	JSON400      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23279,7 +23949,7 @@ type AddTagToFeaturesResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON404 *struct {
+	JSON401 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23289,7 +23959,7 @@ type AddTagToFeaturesResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON415 *struct {
+	JSON403 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23302,7 +23972,7 @@ type AddTagToFeaturesResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r AddTagToFeaturesResponse) Status() string {
+func (r OverwriteFeatureVariantsOnEnvironmentsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -23310,17 +23980,17 @@ func (r AddTagToFeaturesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AddTagToFeaturesResponse) StatusCode() int {
+func (r OverwriteFeatureVariantsOnEnvironmentsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SearchFeaturesResponse struct {
+type GetProjectHealthReportResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *SearchFeaturesSchema
+	JSON200      *HealthReportSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -23354,7 +24024,7 @@ type SearchFeaturesResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r SearchFeaturesResponse) Status() string {
+func (r GetProjectHealthReportResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -23362,40 +24032,28 @@ func (r SearchFeaturesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SearchFeaturesResponse) StatusCode() int {
+func (r GetProjectHealthReportResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetSegmentsResponse struct {
+type GetProjectOverviewResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *SegmentsSchema
-}
+	JSON200      *ProjectOverviewSchema
+	JSON401      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
 
-// Status returns HTTPResponse.Status
-func (r GetSegmentsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSegmentsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
 	}
-	return 0
-}
-
-type CreateSegmentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *AdminSegmentSchema
-	JSON400      *struct {
+	JSON403 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23405,7 +24063,7 @@ type CreateSegmentResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON401 *struct {
+	JSON404 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23415,7 +24073,28 @@ type CreateSegmentResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON403 *struct {
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectOverviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectOverviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReviveFeaturesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23425,7 +24104,7 @@ type CreateSegmentResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON409 *struct {
+	JSON401 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23435,7 +24114,7 @@ type CreateSegmentResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON415 *struct {
+	JSON403 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23448,7 +24127,7 @@ type CreateSegmentResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateSegmentResponse) Status() string {
+func (r ReviveFeaturesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -23456,28 +24135,17 @@ func (r CreateSegmentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateSegmentResponse) StatusCode() int {
+func (r ReviveFeaturesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateFeatureStrategySegmentsResponse struct {
+type StaleFeaturesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *UpdateFeatureStrategySegmentsSchema
-	JSON400      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON401 *struct {
+	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23510,29 +24178,7 @@ type UpdateFeatureStrategySegmentsResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateFeatureStrategySegmentsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateFeatureStrategySegmentsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
-
-type GetSegmentsByStrategyIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SegmentsSchema
-}
-
-// Status returns HTTPResponse.Status
-func (r GetSegmentsByStrategyIdResponse) Status() string {
+func (r StaleFeaturesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -23540,17 +24186,17 @@ func (r GetSegmentsByStrategyIdResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetSegmentsByStrategyIdResponse) StatusCode() int {
+func (r StaleFeaturesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ValidateSegmentResponse struct {
+type AddTagToFeaturesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *struct {
+	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23560,7 +24206,7 @@ type ValidateSegmentResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON401 *struct {
+	JSON403 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23570,7 +24216,7 @@ type ValidateSegmentResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON409 *struct {
+	JSON404 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23593,7 +24239,7 @@ type ValidateSegmentResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r ValidateSegmentResponse) Status() string {
+func (r AddTagToFeaturesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -23601,16 +24247,17 @@ func (r ValidateSegmentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ValidateSegmentResponse) StatusCode() int {
+func (r AddTagToFeaturesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type RemoveSegmentResponse struct {
+type SearchFeaturesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *SearchFeaturesSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -23631,7 +24278,7 @@ type RemoveSegmentResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON409 *struct {
+	JSON404 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23644,7 +24291,7 @@ type RemoveSegmentResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r RemoveSegmentResponse) Status() string {
+func (r SearchFeaturesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -23652,31 +24299,21 @@ func (r RemoveSegmentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r RemoveSegmentResponse) StatusCode() int {
+func (r SearchFeaturesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetSegmentResponse struct {
+type GetSegmentsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AdminSegmentSchema
-	JSON404      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
+	JSON200      *SegmentsSchema
 }
 
 // Status returns HTTPResponse.Status
-func (r GetSegmentResponse) Status() string {
+func (r GetSegmentsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -23684,16 +24321,17 @@ func (r GetSegmentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetSegmentResponse) StatusCode() int {
+func (r GetSegmentsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateSegmentResponse struct {
+type CreateSegmentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON201      *AdminSegmentSchema
 	JSON400      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -23747,29 +24385,7 @@ type UpdateSegmentResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateSegmentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateSegmentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
-
-type GetStrategiesBySegmentIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SegmentStrategiesSchema
-}
-
-// Status returns HTTPResponse.Status
-func (r GetStrategiesBySegmentIdResponse) Status() string {
+func (r CreateSegmentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -23777,17 +24393,17 @@ func (r GetStrategiesBySegmentIdResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetStrategiesBySegmentIdResponse) StatusCode() int {
+func (r CreateSegmentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateSplashSettingsResponse struct {
+type UpdateFeatureStrategySegmentsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *SplashResponseSchema
+	JSON201      *UpdateFeatureStrategySegmentsSchema
 	JSON400      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -23831,50 +24447,7 @@ type UpdateSplashSettingsResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateSplashSettingsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateSplashSettingsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
-
-type ExportResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *StateSchema
-}
-
-// Status returns HTTPResponse.Status
-func (r ExportResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r ExportResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
-
-type ImportResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
-
-// Status returns HTTPResponse.Status
-func (r ImportResponse) Status() string {
+func (r UpdateFeatureStrategySegmentsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -23882,31 +24455,21 @@ func (r ImportResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ImportResponse) StatusCode() int {
+func (r UpdateFeatureStrategySegmentsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAllStrategiesResponse struct {
+type GetSegmentsByStrategyIdResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *StrategiesSchema
-	JSON401      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
+	JSON200      *SegmentsSchema
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllStrategiesResponse) Status() string {
+func (r GetSegmentsByStrategyIdResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -23914,18 +24477,17 @@ func (r GetAllStrategiesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllStrategiesResponse) StatusCode() int {
+func (r GetSegmentsByStrategyIdResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateStrategyResponse struct {
+type ValidateSegmentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *StrategySchema
-	JSON401      *struct {
+	JSON400      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23935,7 +24497,7 @@ type CreateStrategyResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON403 *struct {
+	JSON401 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -23968,7 +24530,7 @@ type CreateStrategyResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateStrategyResponse) Status() string {
+func (r ValidateSegmentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -23976,14 +24538,14 @@ func (r CreateStrategyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateStrategyResponse) StatusCode() int {
+func (r ValidateSegmentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type RemoveStrategyResponse struct {
+type RemoveSegmentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON401      *struct {
@@ -24006,7 +24568,7 @@ type RemoveStrategyResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON404 *struct {
+	JSON409 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -24019,7 +24581,7 @@ type RemoveStrategyResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r RemoveStrategyResponse) Status() string {
+func (r RemoveSegmentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -24027,28 +24589,18 @@ func (r RemoveStrategyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r RemoveStrategyResponse) StatusCode() int {
+func (r RemoveSegmentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetStrategyResponse struct {
+type GetSegmentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *StrategySchema
-	JSON401      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON404 *struct {
+	JSON200      *AdminSegmentSchema
+	JSON404      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -24061,7 +24613,7 @@ type GetStrategyResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r GetStrategyResponse) Status() string {
+func (r GetSegmentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -24069,17 +24621,27 @@ func (r GetStrategyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetStrategyResponse) StatusCode() int {
+func (r GetSegmentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateStrategyResponse struct {
+type UpdateSegmentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON401      *struct {
+	JSON400      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON401 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -24099,7 +24661,7 @@ type UpdateStrategyResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON404 *struct {
+	JSON409 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -24122,7 +24684,7 @@ type UpdateStrategyResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateStrategyResponse) Status() string {
+func (r UpdateSegmentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -24130,17 +24692,50 @@ func (r UpdateStrategyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateStrategyResponse) StatusCode() int {
+func (r UpdateSegmentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeprecateStrategyResponse struct {
+type GetStrategiesBySegmentIdResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON401      *struct {
+	JSON200      *SegmentStrategiesSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStrategiesBySegmentIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStrategiesBySegmentIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSplashSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SplashResponseSchema
+	JSON400      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON401 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -24160,7 +24755,7 @@ type DeprecateStrategyResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON404 *struct {
+	JSON415 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -24173,7 +24768,7 @@ type DeprecateStrategyResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r DeprecateStrategyResponse) Status() string {
+func (r UpdateSplashSettingsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -24181,50 +24776,42 @@ func (r DeprecateStrategyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeprecateStrategyResponse) StatusCode() int {
+func (r UpdateSplashSettingsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ReactivateStrategyResponse struct {
+type ExportResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON401      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
+	JSON200      *StateSchema
+}
 
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
+// Status returns HTTPResponse.Status
+func (r ExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	JSON403 *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
+	return http.StatusText(0)
+}
 
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	JSON404 *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
+	return 0
+}
 
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
+type ImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r ReactivateStrategyResponse) Status() string {
+func (r ImportResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -24232,17 +24819,17 @@ func (r ReactivateStrategyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ReactivateStrategyResponse) StatusCode() int {
+func (r ImportResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetTagTypesResponse struct {
+type GetAllStrategiesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TagTypesSchema
+	JSON200      *StrategiesSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -24250,23 +24837,13 @@ type GetTagTypesResponse struct {
 		// Message A description of what went wrong.
 		Message *string `json:"message,omitempty"`
 
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON403 *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r GetTagTypesResponse) Status() string {
+func (r GetAllStrategiesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -24274,28 +24851,18 @@ func (r GetTagTypesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetTagTypesResponse) StatusCode() int {
+func (r GetAllStrategiesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateTagTypeResponse struct {
+type CreateStrategyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *TagTypeSchema
-	JSON400      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON401 *struct {
+	JSON201      *StrategySchema
+	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -24338,7 +24905,7 @@ type CreateTagTypeResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateTagTypeResponse) Status() string {
+func (r CreateStrategyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -24346,18 +24913,17 @@ func (r CreateTagTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateTagTypeResponse) StatusCode() int {
+func (r CreateStrategyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ValidateTagTypeResponse struct {
+type RemoveStrategyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ValidateTagTypeSchema
-	JSON400      *struct {
+	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -24367,7 +24933,7 @@ type ValidateTagTypeResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON401 *struct {
+	JSON403 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -24377,7 +24943,7 @@ type ValidateTagTypeResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON403 *struct {
+	JSON404 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -24387,7 +24953,29 @@ type ValidateTagTypeResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON409 *struct {
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveStrategyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveStrategyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStrategyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StrategySchema
+	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -24397,7 +24985,7 @@ type ValidateTagTypeResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON415 *struct {
+	JSON404 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -24410,7 +24998,7 @@ type ValidateTagTypeResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r ValidateTagTypeResponse) Status() string {
+func (r GetStrategyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -24418,14 +25006,14 @@ func (r ValidateTagTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ValidateTagTypeResponse) StatusCode() int {
+func (r GetStrategyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteTagTypeResponse struct {
+type UpdateStrategyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON401      *struct {
@@ -24445,13 +25033,33 @@ type DeleteTagTypeResponse struct {
 		// Message A description of what went wrong.
 		Message *string `json:"message,omitempty"`
 
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON404 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON415 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteTagTypeResponse) Status() string {
+func (r UpdateStrategyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -24459,17 +25067,16 @@ func (r DeleteTagTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTagTypeResponse) StatusCode() int {
+func (r UpdateStrategyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetTagTypeResponse struct {
+type DeprecateStrategyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TagTypeSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -24487,13 +25094,23 @@ type GetTagTypeResponse struct {
 		// Message A description of what went wrong.
 		Message *string `json:"message,omitempty"`
 
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON404 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
 }
 
 // Status returns HTTPResponse.Status
-func (r GetTagTypeResponse) Status() string {
+func (r DeprecateStrategyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -24501,27 +25118,17 @@ func (r GetTagTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetTagTypeResponse) StatusCode() int {
+func (r DeprecateStrategyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateTagTypeResponse struct {
+type ReactivateStrategyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *struct {
-		// Id The ID of the error instance
-		Id *string `json:"id,omitempty"`
-
-		// Message A description of what went wrong.
-		Message *string `json:"message,omitempty"`
-
-		// Name The name of the error kind
-		Name *string `json:"name,omitempty"`
-	}
-	JSON401 *struct {
+	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -24541,7 +25148,7 @@ type UpdateTagTypeResponse struct {
 		// Name The name of the error kind
 		Name *string `json:"name,omitempty"`
 	}
-	JSON415 *struct {
+	JSON404 *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
 
@@ -24554,7 +25161,7 @@ type UpdateTagTypeResponse struct {
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateTagTypeResponse) Status() string {
+func (r ReactivateStrategyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -24562,17 +25169,347 @@ func (r UpdateTagTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTagTypeResponse) StatusCode() int {
+func (r ReactivateStrategyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetTagsResponse struct {
+type GetTagTypesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TagsSchema
+	JSON200      *TagTypesSchema
+	JSON401      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON403 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTagTypesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTagTypesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTagTypeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TagTypeSchema
+	JSON400      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON401 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON403 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON409 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON415 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTagTypeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTagTypeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ValidateTagTypeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ValidateTagTypeSchema
+	JSON400      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON401 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON403 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON409 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON415 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateTagTypeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateTagTypeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTagTypeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON403 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTagTypeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTagTypeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTagTypeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagTypeSchema
+	JSON401      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON403 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTagTypeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTagTypeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTagTypeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON401 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON403 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+	JSON415 *struct {
+		// Id The ID of the error instance
+		Id *string `json:"id,omitempty"`
+
+		// Message A description of what went wrong.
+		Message *string `json:"message,omitempty"`
+
+		// Name The name of the error kind
+		Name *string `json:"name,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTagTypeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTagTypeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagsSchema
 	JSON401      *struct {
 		// Id The ID of the error instance
 		Id *string `json:"id,omitempty"`
@@ -26577,6 +27514,23 @@ func (c *ClientWithResponses) GetAllEnvironmentsWithResponse(ctx context.Context
 	return ParseGetAllEnvironmentsResponse(rsp)
 }
 
+// CreateEnvironmentWithBodyWithResponse request with arbitrary body returning *CreateEnvironmentResponse
+func (c *ClientWithResponses) CreateEnvironmentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnvironmentResponse, error) {
+	rsp, err := c.CreateEnvironmentWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEnvironmentResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateEnvironmentWithResponse(ctx context.Context, body CreateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnvironmentResponse, error) {
+	rsp, err := c.CreateEnvironment(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEnvironmentResponse(rsp)
+}
+
 // GetProjectEnvironmentsWithResponse request returning *GetProjectEnvironmentsResponse
 func (c *ClientWithResponses) GetProjectEnvironmentsWithResponse(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*GetProjectEnvironmentsResponse, error) {
 	rsp, err := c.GetProjectEnvironments(ctx, projectId, reqEditors...)
@@ -26603,6 +27557,32 @@ func (c *ClientWithResponses) UpdateSortOrderWithResponse(ctx context.Context, b
 	return ParseUpdateSortOrderResponse(rsp)
 }
 
+// UpdateEnvironmentWithBodyWithResponse request with arbitrary body returning *UpdateEnvironmentResponse
+func (c *ClientWithResponses) UpdateEnvironmentWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEnvironmentResponse, error) {
+	rsp, err := c.UpdateEnvironmentWithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateEnvironmentResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateEnvironmentWithResponse(ctx context.Context, name string, body UpdateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateEnvironmentResponse, error) {
+	rsp, err := c.UpdateEnvironment(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateEnvironmentResponse(rsp)
+}
+
+// RemoveEnvironmentWithResponse request returning *RemoveEnvironmentResponse
+func (c *ClientWithResponses) RemoveEnvironmentWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*RemoveEnvironmentResponse, error) {
+	rsp, err := c.RemoveEnvironment(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveEnvironmentResponse(rsp)
+}
+
 // GetEnvironmentWithResponse request returning *GetEnvironmentResponse
 func (c *ClientWithResponses) GetEnvironmentWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetEnvironmentResponse, error) {
 	rsp, err := c.GetEnvironment(ctx, name, reqEditors...)
@@ -27037,6 +28017,32 @@ func (c *ClientWithResponses) GetProjectsWithResponse(ctx context.Context, reqEd
 	return ParseGetProjectsResponse(rsp)
 }
 
+// CreateProjectWithBodyWithResponse request with arbitrary body returning *CreateProjectResponse
+func (c *ClientWithResponses) CreateProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
+	rsp, err := c.CreateProjectWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateProjectWithResponse(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
+	rsp, err := c.CreateProject(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectResponse(rsp)
+}
+
+// DeleteProjectWithResponse request returning *DeleteProjectResponse
+func (c *ClientWithResponses) DeleteProjectWithResponse(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error) {
+	rsp, err := c.DeleteProject(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectResponse(rsp)
+}
+
 // GetDeprecatedProjectOverviewWithResponse request returning *GetDeprecatedProjectOverviewResponse
 func (c *ClientWithResponses) GetDeprecatedProjectOverviewWithResponse(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*GetDeprecatedProjectOverviewResponse, error) {
 	rsp, err := c.GetDeprecatedProjectOverview(ctx, projectId, reqEditors...)
@@ -27046,6 +28052,23 @@ func (c *ClientWithResponses) GetDeprecatedProjectOverviewWithResponse(ctx conte
 	return ParseGetDeprecatedProjectOverviewResponse(rsp)
 }
 
+// UpdateProjectWithBodyWithResponse request with arbitrary body returning *UpdateProjectResponse
+func (c *ClientWithResponses) UpdateProjectWithBodyWithResponse(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error) {
+	rsp, err := c.UpdateProjectWithBody(ctx, projectId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectWithResponse(ctx context.Context, projectId string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error) {
+	rsp, err := c.UpdateProject(ctx, projectId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectResponse(rsp)
+}
+
 // GetProjectApiTokensWithResponse request returning *GetProjectApiTokensResponse
 func (c *ClientWithResponses) GetProjectApiTokensWithResponse(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*GetProjectApiTokensResponse, error) {
 	rsp, err := c.GetProjectApiTokens(ctx, projectId, reqEditors...)
@@ -29926,26 +30949,42 @@ func ParseGetAllEnvironmentsResponse(rsp *http.Response) (*GetAllEnvironmentsRes
 	return response, nil
 }
 
-// ParseGetProjectEnvironmentsResponse parses an HTTP response from a GetProjectEnvironmentsWithResponse call
-func ParseGetProjectEnvironmentsResponse(rsp *http.Response) (*GetProjectEnvironmentsResponse, error) {
+// ParseCreateEnvironmentResponse parses an HTTP response from a CreateEnvironmentWithResponse call
+func ParseCreateEnvironmentResponse(rsp *http.Response) (*CreateEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectEnvironmentsResponse{
+	response := &CreateEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EnvironmentsProjectSchema
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest EnvironmentSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
@@ -29979,7 +31018,7 @@ func ParseGetProjectEnvironmentsResponse(rsp *http.Response) (*GetProjectEnviron
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -29993,27 +31032,34 @@ func ParseGetProjectEnvironmentsResponse(rsp *http.Response) (*GetProjectEnviron
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON409 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUpdateSortOrderResponse parses an HTTP response from a UpdateSortOrderWithResponse call
-func ParseUpdateSortOrderResponse(rsp *http.Response) (*UpdateSortOrderResponse, error) {
+// ParseGetProjectEnvironmentsResponse parses an HTTP response from a GetProjectEnvironmentsWithResponse call
+func ParseGetProjectEnvironmentsResponse(rsp *http.Response) (*GetProjectEnvironmentsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateSortOrderResponse{
+	response := &GetProjectEnvironmentsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EnvironmentsProjectSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -30067,27 +31113,20 @@ func ParseUpdateSortOrderResponse(rsp *http.Response) (*UpdateSortOrderResponse,
 	return response, nil
 }
 
-// ParseGetEnvironmentResponse parses an HTTP response from a GetEnvironmentWithResponse call
-func ParseGetEnvironmentResponse(rsp *http.Response) (*GetEnvironmentResponse, error) {
+// ParseUpdateSortOrderResponse parses an HTTP response from a UpdateSortOrderWithResponse call
+func ParseUpdateSortOrderResponse(rsp *http.Response) (*UpdateSortOrderResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEnvironmentResponse{
+	response := &UpdateSortOrderResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EnvironmentSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -30141,20 +31180,43 @@ func ParseGetEnvironmentResponse(rsp *http.Response) (*GetEnvironmentResponse, e
 	return response, nil
 }
 
-// ParseToggleEnvironmentOffResponse parses an HTTP response from a ToggleEnvironmentOffWithResponse call
-func ParseToggleEnvironmentOffResponse(rsp *http.Response) (*ToggleEnvironmentOffResponse, error) {
+// ParseUpdateEnvironmentResponse parses an HTTP response from a UpdateEnvironmentWithResponse call
+func ParseUpdateEnvironmentResponse(rsp *http.Response) (*UpdateEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ToggleEnvironmentOffResponse{
+	response := &UpdateEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EnvironmentSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -30208,21 +31270,21 @@ func ParseToggleEnvironmentOffResponse(rsp *http.Response) (*ToggleEnvironmentOf
 	return response, nil
 }
 
-// ParseToggleEnvironmentOnResponse parses an HTTP response from a ToggleEnvironmentOnWithResponse call
-func ParseToggleEnvironmentOnResponse(rsp *http.Response) (*ToggleEnvironmentOnResponse, error) {
+// ParseRemoveEnvironmentResponse parses an HTTP response from a RemoveEnvironmentWithResponse call
+func ParseRemoveEnvironmentResponse(rsp *http.Response) (*RemoveEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ToggleEnvironmentOnResponse{
+	response := &RemoveEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -30236,9 +31298,9 @@ func ParseToggleEnvironmentOnResponse(rsp *http.Response) (*ToggleEnvironmentOnR
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -30252,9 +31314,9 @@ func ParseToggleEnvironmentOnResponse(rsp *http.Response) (*ToggleEnvironmentOnR
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -30268,35 +31330,9 @@ func ParseToggleEnvironmentOnResponse(rsp *http.Response) (*ToggleEnvironmentOnR
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseGetEventsResponse parses an HTTP response from a GetEventsWithResponse call
-func ParseGetEventsResponse(rsp *http.Response) (*GetEventsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &GetEventsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventsSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -30310,55 +31346,29 @@ func ParseGetEventsResponse(rsp *http.Response) (*GetEventsResponse, error) {
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseSearchEventsResponse parses an HTTP response from a SearchEventsWithResponse call
-func ParseSearchEventsResponse(rsp *http.Response) (*SearchEventsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &SearchEventsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventsSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetEventsForToggleResponse parses an HTTP response from a GetEventsForToggleWithResponse call
-func ParseGetEventsForToggleResponse(rsp *http.Response) (*GetEventsForToggleResponse, error) {
+// ParseGetEnvironmentResponse parses an HTTP response from a GetEnvironmentWithResponse call
+func ParseGetEnvironmentResponse(rsp *http.Response) (*GetEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEventsForToggleResponse{
+	response := &GetEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureEventsSchema
+		var dest EnvironmentSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -30380,33 +31390,23 @@ func ParseGetEventsForToggleResponse(rsp *http.Response) (*GetEventsForToggleRes
 		}
 		response.JSON401 = &dest
 
-	}
-
-	return response, nil
-}
-
-// ParseGetAllFeatureTypesResponse parses an HTTP response from a GetAllFeatureTypesWithResponse call
-func ParseGetAllFeatureTypesResponse(rsp *http.Response) (*GetAllFeatureTypesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
 
-	response := &GetAllFeatureTypesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureTypesSchema
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -30420,50 +31420,27 @@ func ParseGetAllFeatureTypesResponse(rsp *http.Response) (*GetAllFeatureTypesRes
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUpdateFeatureTypeLifetimeResponse parses an HTTP response from a UpdateFeatureTypeLifetimeWithResponse call
-func ParseUpdateFeatureTypeLifetimeResponse(rsp *http.Response) (*UpdateFeatureTypeLifetimeResponse, error) {
+// ParseToggleEnvironmentOffResponse parses an HTTP response from a ToggleEnvironmentOffWithResponse call
+func ParseToggleEnvironmentOffResponse(rsp *http.Response) (*ToggleEnvironmentOffResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateFeatureTypeLifetimeResponse{
+	response := &ToggleEnvironmentOffResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureTypeSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -30512,7 +31489,26 @@ func ParseUpdateFeatureTypeLifetimeResponse(rsp *http.Response) (*UpdateFeatureT
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	}
+
+	return response, nil
+}
+
+// ParseToggleEnvironmentOnResponse parses an HTTP response from a ToggleEnvironmentOnWithResponse call
+func ParseToggleEnvironmentOnResponse(rsp *http.Response) (*ToggleEnvironmentOnResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ToggleEnvironmentOnResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -30526,9 +31522,9 @@ func ParseUpdateFeatureTypeLifetimeResponse(rsp *http.Response) (*UpdateFeatureT
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -30542,29 +31538,45 @@ func ParseUpdateFeatureTypeLifetimeResponse(rsp *http.Response) (*UpdateFeatureT
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON415 = &dest
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetAllTogglesResponse parses an HTTP response from a GetAllTogglesWithResponse call
-func ParseGetAllTogglesResponse(rsp *http.Response) (*GetAllTogglesResponse, error) {
+// ParseGetEventsResponse parses an HTTP response from a GetEventsWithResponse call
+func ParseGetEventsResponse(rsp *http.Response) (*GetEventsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllTogglesResponse{
+	response := &GetEventsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeaturesSchema
+		var dest EventsSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -30586,49 +31598,59 @@ func ParseGetAllTogglesResponse(rsp *http.Response) (*GetAllTogglesResponse, err
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
+	}
 
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
+	return response, nil
+}
 
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
+// ParseSearchEventsResponse parses an HTTP response from a SearchEventsWithResponse call
+func ParseSearchEventsResponse(rsp *http.Response) (*SearchEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventsSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseExportFeaturesResponse parses an HTTP response from a ExportFeaturesWithResponse call
-func ParseExportFeaturesResponse(rsp *http.Response) (*ExportFeaturesResponse, error) {
+// ParseGetEventsForToggleResponse parses an HTTP response from a GetEventsForToggleWithResponse call
+func ParseGetEventsForToggleResponse(rsp *http.Response) (*GetEventsForToggleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ExportFeaturesResponse{
+	response := &GetEventsForToggleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ExportResultSchema
+		var dest FeatureEventsSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -30642,28 +31664,35 @@ func ParseExportFeaturesResponse(rsp *http.Response) (*ExportFeaturesResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseImportTogglesResponse parses an HTTP response from a ImportTogglesWithResponse call
-func ParseImportTogglesResponse(rsp *http.Response) (*ImportTogglesResponse, error) {
+// ParseGetAllFeatureTypesResponse parses an HTTP response from a GetAllFeatureTypesWithResponse call
+func ParseGetAllFeatureTypesResponse(rsp *http.Response) (*GetAllFeatureTypesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ImportTogglesResponse{
+	response := &GetAllFeatureTypesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeatureTypesSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -30677,35 +31706,35 @@ func ParseImportTogglesResponse(rsp *http.Response) (*ImportTogglesResponse, err
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseValidateImportResponse parses an HTTP response from a ValidateImportWithResponse call
-func ParseValidateImportResponse(rsp *http.Response) (*ValidateImportResponse, error) {
+// ParseUpdateFeatureTypeLifetimeResponse parses an HTTP response from a UpdateFeatureTypeLifetimeWithResponse call
+func ParseUpdateFeatureTypeLifetimeResponse(rsp *http.Response) (*UpdateFeatureTypeLifetimeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ValidateImportResponse{
+	response := &UpdateFeatureTypeLifetimeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ImportTogglesValidateSchema
+		var dest FeatureTypeSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -30719,28 +31748,25 @@ func ParseValidateImportResponse(rsp *http.Response) (*ValidateImportResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
-
-	}
+		response.JSON400 = &dest
 
-	return response, nil
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
 
-// ParseValidateFeatureResponse parses an HTTP response from a ValidateFeatureWithResponse call
-func ParseValidateFeatureResponse(rsp *http.Response) (*ValidateFeatureResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
 
-	response := &ValidateFeatureResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -30754,9 +31780,9 @@ func ParseValidateFeatureResponse(rsp *http.Response) (*ValidateFeatureResponse,
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -30770,7 +31796,7 @@ func ParseValidateFeatureResponse(rsp *http.Response) (*ValidateFeatureResponse,
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest struct {
@@ -30809,22 +31835,22 @@ func ParseValidateFeatureResponse(rsp *http.Response) (*ValidateFeatureResponse,
 	return response, nil
 }
 
-// ParseListTagsResponse parses an HTTP response from a ListTagsWithResponse call
-func ParseListTagsResponse(rsp *http.Response) (*ListTagsResponse, error) {
+// ParseGetAllTogglesResponse parses an HTTP response from a GetAllTogglesWithResponse call
+func ParseGetAllTogglesResponse(rsp *http.Response) (*GetAllTogglesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListTagsResponse{
+	response := &GetAllTogglesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TagsSchema
+		var dest FeaturesSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -30862,49 +31888,33 @@ func ParseListTagsResponse(rsp *http.Response) (*ListTagsResponse, error) {
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseAddTagResponse parses an HTTP response from a AddTagWithResponse call
-func ParseAddTagResponse(rsp *http.Response) (*AddTagResponse, error) {
+// ParseExportFeaturesResponse parses an HTTP response from a ExportFeaturesWithResponse call
+func ParseExportFeaturesResponse(rsp *http.Response) (*ExportFeaturesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddTagResponse{
+	response := &ExportFeaturesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TagSchema
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExportResultSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -30918,9 +31928,28 @@ func ParseAddTagResponse(rsp *http.Response) (*AddTagResponse, error) {
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	}
+
+	return response, nil
+}
+
+// ParseImportTogglesResponse parses an HTTP response from a ImportTogglesWithResponse call
+func ParseImportTogglesResponse(rsp *http.Response) (*ImportTogglesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportTogglesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -30934,23 +31963,33 @@ func ParseAddTagResponse(rsp *http.Response) (*AddTagResponse, error) {
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
+	}
 
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
+	return response, nil
+}
 
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
+// ParseValidateImportResponse parses an HTTP response from a ValidateImportWithResponse call
+func ParseValidateImportResponse(rsp *http.Response) (*ValidateImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ValidateImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportTogglesValidateSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
@@ -30973,27 +32012,20 @@ func ParseAddTagResponse(rsp *http.Response) (*AddTagResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateTagsResponse parses an HTTP response from a UpdateTagsWithResponse call
-func ParseUpdateTagsResponse(rsp *http.Response) (*UpdateTagsResponse, error) {
+// ParseValidateFeatureResponse parses an HTTP response from a ValidateFeatureWithResponse call
+func ParseValidateFeatureResponse(rsp *http.Response) (*ValidateFeatureResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateTagsResponse{
+	response := &ValidateFeatureResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TagsSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			// Id The ID of the error instance
@@ -31026,7 +32058,7 @@ func ParseUpdateTagsResponse(rsp *http.Response) (*UpdateTagsResponse, error) {
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -31040,9 +32072,9 @@ func ParseUpdateTagsResponse(rsp *http.Response) (*UpdateTagsResponse, error) {
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -31056,22 +32088,276 @@ func ParseUpdateTagsResponse(rsp *http.Response) (*UpdateTagsResponse, error) {
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON415 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseRemoveTagResponse parses an HTTP response from a RemoveTagWithResponse call
-func ParseRemoveTagResponse(rsp *http.Response) (*RemoveTagResponse, error) {
+// ParseListTagsResponse parses an HTTP response from a ListTagsWithResponse call
+func ParseListTagsResponse(rsp *http.Response) (*ListTagsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RemoveTagResponse{
+	response := &ListTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TagsSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddTagResponse parses an HTTP response from a AddTagWithResponse call
+func ParseAddTagResponse(rsp *http.Response) (*AddTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TagSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTagsResponse parses an HTTP response from a UpdateTagsWithResponse call
+func ParseUpdateTagsResponse(rsp *http.Response) (*UpdateTagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TagsSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveTagResponse parses an HTTP response from a RemoveTagWithResponse call
+func ParseRemoveTagResponse(rsp *http.Response) (*RemoveTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveTagResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -32037,26 +33323,42 @@ func ParseGetProjectsResponse(rsp *http.Response) (*GetProjectsResponse, error)
 	return response, nil
 }
 
-// ParseGetDeprecatedProjectOverviewResponse parses an HTTP response from a GetDeprecatedProjectOverviewWithResponse call
-func ParseGetDeprecatedProjectOverviewResponse(rsp *http.Response) (*GetDeprecatedProjectOverviewResponse, error) {
+// ParseCreateProjectResponse parses an HTTP response from a CreateProjectWithResponse call
+func ParseCreateProjectResponse(rsp *http.Response) (*CreateProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDeprecatedProjectOverviewResponse{
+	response := &CreateProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeprecatedProjectOverviewSchema
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ProjectCreatedSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
@@ -32090,7 +33392,7 @@ func ParseGetDeprecatedProjectOverviewResponse(rsp *http.Response) (*GetDeprecat
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -32104,34 +33406,43 @@ func ParseGetDeprecatedProjectOverviewResponse(rsp *http.Response) (*GetDeprecat
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetProjectApiTokensResponse parses an HTTP response from a GetProjectApiTokensWithResponse call
-func ParseGetProjectApiTokensResponse(rsp *http.Response) (*GetProjectApiTokensResponse, error) {
+// ParseDeleteProjectResponse parses an HTTP response from a DeleteProjectWithResponse call
+func ParseDeleteProjectResponse(rsp *http.Response) (*DeleteProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectApiTokensResponse{
+	response := &DeleteProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ApiTokensSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -32185,42 +33496,26 @@ func ParseGetProjectApiTokensResponse(rsp *http.Response) (*GetProjectApiTokensR
 	return response, nil
 }
 
-// ParseCreateProjectApiTokenResponse parses an HTTP response from a CreateProjectApiTokenWithResponse call
-func ParseCreateProjectApiTokenResponse(rsp *http.Response) (*CreateProjectApiTokenResponse, error) {
+// ParseGetDeprecatedProjectOverviewResponse parses an HTTP response from a GetDeprecatedProjectOverviewWithResponse call
+func ParseGetDeprecatedProjectOverviewResponse(rsp *http.Response) (*GetDeprecatedProjectOverviewResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectApiTokenResponse{
+	response := &GetDeprecatedProjectOverviewResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ApiTokenSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeprecatedProjectOverviewSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
@@ -32275,15 +33570,15 @@ func ParseCreateProjectApiTokenResponse(rsp *http.Response) (*CreateProjectApiTo
 	return response, nil
 }
 
-// ParseDeleteProjectApiTokenResponse parses an HTTP response from a DeleteProjectApiTokenWithResponse call
-func ParseDeleteProjectApiTokenResponse(rsp *http.Response) (*DeleteProjectApiTokenResponse, error) {
+// ParseUpdateProjectResponse parses an HTTP response from a UpdateProjectWithResponse call
+func ParseUpdateProjectResponse(rsp *http.Response) (*UpdateProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectApiTokenResponse{
+	response := &UpdateProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -32353,40 +33648,47 @@ func ParseDeleteProjectApiTokenResponse(rsp *http.Response) (*DeleteProjectApiTo
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseArchiveFeaturesResponse parses an HTTP response from a ArchiveFeaturesWithResponse call
-func ParseArchiveFeaturesResponse(rsp *http.Response) (*ArchiveFeaturesResponse, error) {
+// ParseGetProjectApiTokensResponse parses an HTTP response from a GetProjectApiTokensWithResponse call
+func ParseGetProjectApiTokensResponse(rsp *http.Response) (*GetProjectApiTokensResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ArchiveFeaturesResponse{
+	response := &GetProjectApiTokensResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiTokensSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
@@ -32420,7 +33722,7 @@ func ParseArchiveFeaturesResponse(rsp *http.Response) (*ArchiveFeaturesResponse,
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -32434,33 +33736,33 @@ func ParseArchiveFeaturesResponse(rsp *http.Response) (*ArchiveFeaturesResponse,
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON415 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseValidateArchiveFeaturesResponse parses an HTTP response from a ValidateArchiveFeaturesWithResponse call
-func ParseValidateArchiveFeaturesResponse(rsp *http.Response) (*ValidateArchiveFeaturesResponse, error) {
+// ParseCreateProjectApiTokenResponse parses an HTTP response from a CreateProjectApiTokenWithResponse call
+func ParseCreateProjectApiTokenResponse(rsp *http.Response) (*CreateProjectApiTokenResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ValidateArchiveFeaturesResponse{
+	response := &CreateProjectApiTokenResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ValidateArchiveFeaturesSchema
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ApiTokenSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
@@ -32510,7 +33812,7 @@ func ParseValidateArchiveFeaturesResponse(rsp *http.Response) (*ValidateArchiveF
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -32524,22 +33826,22 @@ func ParseValidateArchiveFeaturesResponse(rsp *http.Response) (*ValidateArchiveF
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON415 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseBulkToggleFeaturesEnvironmentOffResponse parses an HTTP response from a BulkToggleFeaturesEnvironmentOffWithResponse call
-func ParseBulkToggleFeaturesEnvironmentOffResponse(rsp *http.Response) (*BulkToggleFeaturesEnvironmentOffResponse, error) {
+// ParseDeleteProjectApiTokenResponse parses an HTTP response from a DeleteProjectApiTokenWithResponse call
+func ParseDeleteProjectApiTokenResponse(rsp *http.Response) (*DeleteProjectApiTokenResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &BulkToggleFeaturesEnvironmentOffResponse{
+	response := &DeleteProjectApiTokenResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -32609,52 +33911,20 @@ func ParseBulkToggleFeaturesEnvironmentOffResponse(rsp *http.Response) (*BulkTog
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON413 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON415 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseBulkToggleFeaturesEnvironmentOnResponse parses an HTTP response from a BulkToggleFeaturesEnvironmentOnWithResponse call
-func ParseBulkToggleFeaturesEnvironmentOnResponse(rsp *http.Response) (*BulkToggleFeaturesEnvironmentOnResponse, error) {
+// ParseArchiveFeaturesResponse parses an HTTP response from a ArchiveFeaturesWithResponse call
+func ParseArchiveFeaturesResponse(rsp *http.Response) (*ArchiveFeaturesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &BulkToggleFeaturesEnvironmentOnResponse{
+	response := &ArchiveFeaturesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -32708,38 +33978,6 @@ func ParseBulkToggleFeaturesEnvironmentOnResponse(rsp *http.Response) (*BulkTogg
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON413 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
 		var dest struct {
 			// Id The ID of the error instance
@@ -32761,53 +33999,28 @@ func ParseBulkToggleFeaturesEnvironmentOnResponse(rsp *http.Response) (*BulkTogg
 	return response, nil
 }
 
-// ParseDeleteFeaturesResponse parses an HTTP response from a DeleteFeaturesWithResponse call
-func ParseDeleteFeaturesResponse(rsp *http.Response) (*DeleteFeaturesResponse, error) {
+// ParseValidateArchiveFeaturesResponse parses an HTTP response from a ValidateArchiveFeaturesWithResponse call
+func ParseValidateArchiveFeaturesResponse(rsp *http.Response) (*ValidateArchiveFeaturesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteFeaturesResponse{
+	response := &ValidateArchiveFeaturesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ValidateArchiveFeaturesSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -32821,33 +34034,7 @@ func ParseDeleteFeaturesResponse(rsp *http.Response) (*DeleteFeaturesResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseCheckDependenciesExistResponse parses an HTTP response from a CheckDependenciesExistWithResponse call
-func ParseCheckDependenciesExistResponse(rsp *http.Response) (*CheckDependenciesExistResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &CheckDependenciesExistResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DependenciesExistSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
@@ -32881,33 +34068,42 @@ func ParseCheckDependenciesExistResponse(rsp *http.Response) (*CheckDependencies
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetProjectDoraResponse parses an HTTP response from a GetProjectDoraWithResponse call
-func ParseGetProjectDoraResponse(rsp *http.Response) (*GetProjectDoraResponse, error) {
+// ParseBulkToggleFeaturesEnvironmentOffResponse parses an HTTP response from a BulkToggleFeaturesEnvironmentOffWithResponse call
+func ParseBulkToggleFeaturesEnvironmentOffResponse(rsp *http.Response) (*BulkToggleFeaturesEnvironmentOffResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectDoraResponse{
+	response := &BulkToggleFeaturesEnvironmentOffResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectDoraMetricsSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -32921,9 +34117,9 @@ func ParseGetProjectDoraResponse(rsp *http.Response) (*GetProjectDoraResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -32937,9 +34133,9 @@ func ParseGetProjectDoraResponse(rsp *http.Response) (*GetProjectDoraResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -32953,28 +34149,9 @@ func ParseGetProjectDoraResponse(rsp *http.Response) (*GetProjectDoraResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseAddEnvironmentToProjectResponse parses an HTTP response from a AddEnvironmentToProjectWithResponse call
-func ParseAddEnvironmentToProjectResponse(rsp *http.Response) (*AddEnvironmentToProjectResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &AddEnvironmentToProjectResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+		response.JSON403 = &dest
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -32988,9 +34165,9 @@ func ParseAddEnvironmentToProjectResponse(rsp *http.Response) (*AddEnvironmentTo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -33004,9 +34181,9 @@ func ParseAddEnvironmentToProjectResponse(rsp *http.Response) (*AddEnvironmentTo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON413 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -33020,22 +34197,22 @@ func ParseAddEnvironmentToProjectResponse(rsp *http.Response) (*AddEnvironmentTo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON415 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseRemoveEnvironmentFromProjectResponse parses an HTTP response from a RemoveEnvironmentFromProjectWithResponse call
-func ParseRemoveEnvironmentFromProjectResponse(rsp *http.Response) (*RemoveEnvironmentFromProjectResponse, error) {
+// ParseBulkToggleFeaturesEnvironmentOnResponse parses an HTTP response from a BulkToggleFeaturesEnvironmentOnWithResponse call
+func ParseBulkToggleFeaturesEnvironmentOnResponse(rsp *http.Response) (*BulkToggleFeaturesEnvironmentOnResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RemoveEnvironmentFromProjectResponse{
+	response := &BulkToggleFeaturesEnvironmentOnResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -33089,33 +34266,39 @@ func ParseRemoveEnvironmentFromProjectResponse(rsp *http.Response) (*RemoveEnvir
 		}
 		response.JSON403 = &dest
 
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
 
-	return response, nil
-}
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
 
-// ParseAddDefaultStrategyToProjectEnvironmentResponse parses an HTTP response from a AddDefaultStrategyToProjectEnvironmentWithResponse call
-func ParseAddDefaultStrategyToProjectEnvironmentResponse(rsp *http.Response) (*AddDefaultStrategyToProjectEnvironmentResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	response := &AddDefaultStrategyToProjectEnvironmentResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CreateFeatureStrategySchema
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON413 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -33129,27 +34312,43 @@ func ParseAddDefaultStrategyToProjectEnvironmentResponse(rsp *http.Response) (*A
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON415 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseRemoveFavoriteProjectResponse parses an HTTP response from a RemoveFavoriteProjectWithResponse call
-func ParseRemoveFavoriteProjectResponse(rsp *http.Response) (*RemoveFavoriteProjectResponse, error) {
+// ParseDeleteFeaturesResponse parses an HTTP response from a DeleteFeaturesWithResponse call
+func ParseDeleteFeaturesResponse(rsp *http.Response) (*DeleteFeaturesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RemoveFavoriteProjectResponse{
+	response := &DeleteFeaturesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -33166,7 +34365,7 @@ func ParseRemoveFavoriteProjectResponse(rsp *http.Response) (*RemoveFavoriteProj
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -33180,27 +34379,34 @@ func ParseRemoveFavoriteProjectResponse(rsp *http.Response) (*RemoveFavoriteProj
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAddFavoriteProjectResponse parses an HTTP response from a AddFavoriteProjectWithResponse call
-func ParseAddFavoriteProjectResponse(rsp *http.Response) (*AddFavoriteProjectResponse, error) {
+// ParseCheckDependenciesExistResponse parses an HTTP response from a CheckDependenciesExistWithResponse call
+func ParseCheckDependenciesExistResponse(rsp *http.Response) (*CheckDependenciesExistResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddFavoriteProjectResponse{
+	response := &CheckDependenciesExistResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DependenciesExistSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -33217,7 +34423,7 @@ func ParseAddFavoriteProjectResponse(rsp *http.Response) (*AddFavoriteProjectRes
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -33231,35 +34437,35 @@ func ParseAddFavoriteProjectResponse(rsp *http.Response) (*AddFavoriteProjectRes
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetFeaturesResponse parses an HTTP response from a GetFeaturesWithResponse call
-func ParseGetFeaturesResponse(rsp *http.Response) (*GetFeaturesResponse, error) {
+// ParseGetProjectDoraResponse parses an HTTP response from a GetProjectDoraWithResponse call
+func ParseGetProjectDoraResponse(rsp *http.Response) (*GetProjectDoraResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFeaturesResponse{
+	response := &GetProjectDoraResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeaturesSchema
+		var dest ProjectDoraMetricsSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -33273,9 +34479,9 @@ func ParseGetFeaturesResponse(rsp *http.Response) (*GetFeaturesResponse, error)
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -33289,9 +34495,9 @@ func ParseGetFeaturesResponse(rsp *http.Response) (*GetFeaturesResponse, error)
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -33305,34 +34511,27 @@ func ParseGetFeaturesResponse(rsp *http.Response) (*GetFeaturesResponse, error)
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseCreateFeatureResponse parses an HTTP response from a CreateFeatureWithResponse call
-func ParseCreateFeatureResponse(rsp *http.Response) (*CreateFeatureResponse, error) {
+// ParseAddEnvironmentToProjectResponse parses an HTTP response from a AddEnvironmentToProjectWithResponse call
+func ParseAddEnvironmentToProjectResponse(rsp *http.Response) (*AddEnvironmentToProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateFeatureResponse{
+	response := &AddEnvironmentToProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -33365,23 +34564,7 @@ func ParseCreateFeatureResponse(rsp *http.Response) (*CreateFeatureResponse, err
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -33395,28 +34578,28 @@ func ParseCreateFeatureResponse(rsp *http.Response) (*CreateFeatureResponse, err
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON415 = &dest
+		response.JSON409 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseArchiveFeatureResponse parses an HTTP response from a ArchiveFeatureWithResponse call
-func ParseArchiveFeatureResponse(rsp *http.Response) (*ArchiveFeatureResponse, error) {
+// ParseRemoveEnvironmentFromProjectResponse parses an HTTP response from a RemoveEnvironmentFromProjectWithResponse call
+func ParseRemoveEnvironmentFromProjectResponse(rsp *http.Response) (*RemoveEnvironmentFromProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ArchiveFeatureResponse{
+	response := &RemoveEnvironmentFromProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -33430,9 +34613,9 @@ func ParseArchiveFeatureResponse(rsp *http.Response) (*ArchiveFeatureResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -33446,9 +34629,9 @@ func ParseArchiveFeatureResponse(rsp *http.Response) (*ArchiveFeatureResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -33462,35 +34645,35 @@ func ParseArchiveFeatureResponse(rsp *http.Response) (*ArchiveFeatureResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetFeatureResponse parses an HTTP response from a GetFeatureWithResponse call
-func ParseGetFeatureResponse(rsp *http.Response) (*GetFeatureResponse, error) {
+// ParseAddDefaultStrategyToProjectEnvironmentResponse parses an HTTP response from a AddDefaultStrategyToProjectEnvironmentWithResponse call
+func ParseAddDefaultStrategyToProjectEnvironmentResponse(rsp *http.Response) (*AddDefaultStrategyToProjectEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFeatureResponse{
+	response := &AddDefaultStrategyToProjectEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureSchema
+		var dest CreateFeatureStrategySchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -33504,9 +34687,28 @@ func ParseGetFeatureResponse(rsp *http.Response) (*GetFeatureResponse, error) {
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	}
+
+	return response, nil
+}
+
+// ParseRemoveFavoriteProjectResponse parses an HTTP response from a RemoveFavoriteProjectWithResponse call
+func ParseRemoveFavoriteProjectResponse(rsp *http.Response) (*RemoveFavoriteProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveFavoriteProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -33520,7 +34722,7 @@ func ParseGetFeatureResponse(rsp *http.Response) (*GetFeatureResponse, error) {
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
@@ -33543,27 +34745,20 @@ func ParseGetFeatureResponse(rsp *http.Response) (*GetFeatureResponse, error) {
 	return response, nil
 }
 
-// ParsePatchFeatureResponse parses an HTTP response from a PatchFeatureWithResponse call
-func ParsePatchFeatureResponse(rsp *http.Response) (*PatchFeatureResponse, error) {
+// ParseAddFavoriteProjectResponse parses an HTTP response from a AddFavoriteProjectWithResponse call
+func ParseAddFavoriteProjectResponse(rsp *http.Response) (*AddFavoriteProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchFeatureResponse{
+	response := &AddFavoriteProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -33580,22 +34775,6 @@ func ParsePatchFeatureResponse(rsp *http.Response) (*PatchFeatureResponse, error
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
@@ -33612,65 +34791,33 @@ func ParsePatchFeatureResponse(rsp *http.Response) (*PatchFeatureResponse, error
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON415 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseUpdateFeatureResponse parses an HTTP response from a UpdateFeatureWithResponse call
-func ParseUpdateFeatureResponse(rsp *http.Response) (*UpdateFeatureResponse, error) {
+// ParseGetFeaturesResponse parses an HTTP response from a GetFeaturesWithResponse call
+func ParseGetFeaturesResponse(rsp *http.Response) (*GetFeaturesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateFeatureResponse{
+	response := &GetFeaturesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureSchema
+		var dest FeaturesSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -33684,9 +34831,9 @@ func ParseUpdateFeatureResponse(rsp *http.Response) (*UpdateFeatureResponse, err
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -33700,9 +34847,9 @@ func ParseUpdateFeatureResponse(rsp *http.Response) (*UpdateFeatureResponse, err
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -33716,22 +34863,22 @@ func ParseUpdateFeatureResponse(rsp *http.Response) (*UpdateFeatureResponse, err
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON415 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseCloneFeatureResponse parses an HTTP response from a CloneFeatureWithResponse call
-func ParseCloneFeatureResponse(rsp *http.Response) (*CloneFeatureResponse, error) {
+// ParseCreateFeatureResponse parses an HTTP response from a CreateFeatureWithResponse call
+func ParseCreateFeatureResponse(rsp *http.Response) (*CreateFeatureResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CloneFeatureResponse{
+	response := &CreateFeatureResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -33813,15 +34960,15 @@ func ParseCloneFeatureResponse(rsp *http.Response) (*CloneFeatureResponse, error
 	return response, nil
 }
 
-// ParseDeleteFeatureDependenciesResponse parses an HTTP response from a DeleteFeatureDependenciesWithResponse call
-func ParseDeleteFeatureDependenciesResponse(rsp *http.Response) (*DeleteFeatureDependenciesResponse, error) {
+// ParseArchiveFeatureResponse parses an HTTP response from a ArchiveFeatureWithResponse call
+func ParseArchiveFeatureResponse(rsp *http.Response) (*ArchiveFeatureResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteFeatureDependenciesResponse{
+	response := &ArchiveFeatureResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -33880,20 +35027,27 @@ func ParseDeleteFeatureDependenciesResponse(rsp *http.Response) (*DeleteFeatureD
 	return response, nil
 }
 
-// ParseAddFeatureDependencyResponse parses an HTTP response from a AddFeatureDependencyWithResponse call
-func ParseAddFeatureDependencyResponse(rsp *http.Response) (*AddFeatureDependencyResponse, error) {
+// ParseGetFeatureResponse parses an HTTP response from a GetFeatureWithResponse call
+func ParseGetFeatureResponse(rsp *http.Response) (*GetFeatureResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddFeatureDependencyResponse{
+	response := &GetFeatureResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeatureSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -33947,20 +35101,27 @@ func ParseAddFeatureDependencyResponse(rsp *http.Response) (*AddFeatureDependenc
 	return response, nil
 }
 
-// ParseDeleteFeatureDependencyResponse parses an HTTP response from a DeleteFeatureDependencyWithResponse call
-func ParseDeleteFeatureDependencyResponse(rsp *http.Response) (*DeleteFeatureDependencyResponse, error) {
+// ParsePatchFeatureResponse parses an HTTP response from a PatchFeatureWithResponse call
+func ParsePatchFeatureResponse(rsp *http.Response) (*PatchFeatureResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteFeatureDependencyResponse{
+	response := &PatchFeatureResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeatureSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -34009,27 +35170,43 @@ func ParseDeleteFeatureDependencyResponse(rsp *http.Response) (*DeleteFeatureDep
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetFeatureEnvironmentResponse parses an HTTP response from a GetFeatureEnvironmentWithResponse call
-func ParseGetFeatureEnvironmentResponse(rsp *http.Response) (*GetFeatureEnvironmentResponse, error) {
+// ParseUpdateFeatureResponse parses an HTTP response from a UpdateFeatureWithResponse call
+func ParseUpdateFeatureResponse(rsp *http.Response) (*UpdateFeatureResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFeatureEnvironmentResponse{
+	response := &UpdateFeatureResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureEnvironmentSchema
+		var dest FeatureSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -34083,20 +35260,36 @@ func ParseGetFeatureEnvironmentResponse(rsp *http.Response) (*GetFeatureEnvironm
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseToggleFeatureEnvironmentOffResponse parses an HTTP response from a ToggleFeatureEnvironmentOffWithResponse call
-func ParseToggleFeatureEnvironmentOffResponse(rsp *http.Response) (*ToggleFeatureEnvironmentOffResponse, error) {
+// ParseCloneFeatureResponse parses an HTTP response from a CloneFeatureWithResponse call
+func ParseCloneFeatureResponse(rsp *http.Response) (*CloneFeatureResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ToggleFeatureEnvironmentOffResponse{
+	response := &CloneFeatureResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -34109,7 +35302,7 @@ func ParseToggleFeatureEnvironmentOffResponse(rsp *http.Response) (*ToggleFeatur
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -34123,9 +35316,9 @@ func ParseToggleFeatureEnvironmentOffResponse(rsp *http.Response) (*ToggleFeatur
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -34139,9 +35332,9 @@ func ParseToggleFeatureEnvironmentOffResponse(rsp *http.Response) (*ToggleFeatur
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -34155,9 +35348,9 @@ func ParseToggleFeatureEnvironmentOffResponse(rsp *http.Response) (*ToggleFeatur
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -34171,50 +35364,27 @@ func ParseToggleFeatureEnvironmentOffResponse(rsp *http.Response) (*ToggleFeatur
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON415 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseToggleFeatureEnvironmentOnResponse parses an HTTP response from a ToggleFeatureEnvironmentOnWithResponse call
-func ParseToggleFeatureEnvironmentOnResponse(rsp *http.Response) (*ToggleFeatureEnvironmentOnResponse, error) {
+// ParseDeleteFeatureDependenciesResponse parses an HTTP response from a DeleteFeatureDependenciesWithResponse call
+func ParseDeleteFeatureDependenciesResponse(rsp *http.Response) (*DeleteFeatureDependenciesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ToggleFeatureEnvironmentOnResponse{
+	response := &DeleteFeatureDependenciesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -34268,27 +35438,20 @@ func ParseToggleFeatureEnvironmentOnResponse(rsp *http.Response) (*ToggleFeature
 	return response, nil
 }
 
-// ParseGetFeatureStrategiesResponse parses an HTTP response from a GetFeatureStrategiesWithResponse call
-func ParseGetFeatureStrategiesResponse(rsp *http.Response) (*GetFeatureStrategiesResponse, error) {
+// ParseAddFeatureDependencyResponse parses an HTTP response from a AddFeatureDependencyWithResponse call
+func ParseAddFeatureDependencyResponse(rsp *http.Response) (*AddFeatureDependencyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFeatureStrategiesResponse{
+	response := &AddFeatureDependencyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureStrategiesSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -34342,27 +35505,20 @@ func ParseGetFeatureStrategiesResponse(rsp *http.Response) (*GetFeatureStrategie
 	return response, nil
 }
 
-// ParseAddFeatureStrategyResponse parses an HTTP response from a AddFeatureStrategyWithResponse call
-func ParseAddFeatureStrategyResponse(rsp *http.Response) (*AddFeatureStrategyResponse, error) {
+// ParseDeleteFeatureDependencyResponse parses an HTTP response from a DeleteFeatureDependencyWithResponse call
+func ParseDeleteFeatureDependencyResponse(rsp *http.Response) (*DeleteFeatureDependencyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddFeatureStrategyResponse{
+	response := &DeleteFeatureDependencyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureStrategySchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -34416,160 +35572,26 @@ func ParseAddFeatureStrategyResponse(rsp *http.Response) (*AddFeatureStrategyRes
 	return response, nil
 }
 
-// ParseSetStrategySortOrderResponse parses an HTTP response from a SetStrategySortOrderWithResponse call
-func ParseSetStrategySortOrderResponse(rsp *http.Response) (*SetStrategySortOrderResponse, error) {
+// ParseGetFeatureEnvironmentResponse parses an HTTP response from a GetFeatureEnvironmentWithResponse call
+func ParseGetFeatureEnvironmentResponse(rsp *http.Response) (*GetFeatureEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SetStrategySortOrderResponse{
+	response := &GetFeatureEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeatureEnvironmentSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseDeleteFeatureStrategyResponse parses an HTTP response from a DeleteFeatureStrategyWithResponse call
-func ParseDeleteFeatureStrategyResponse(rsp *http.Response) (*DeleteFeatureStrategyResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &DeleteFeatureStrategyResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseGetFeatureStrategyResponse parses an HTTP response from a GetFeatureStrategyWithResponse call
-func ParseGetFeatureStrategyResponse(rsp *http.Response) (*GetFeatureStrategyResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &GetFeatureStrategyResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureStrategySchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
@@ -34624,22 +35646,22 @@ func ParseGetFeatureStrategyResponse(rsp *http.Response) (*GetFeatureStrategyRes
 	return response, nil
 }
 
-// ParsePatchFeatureStrategyResponse parses an HTTP response from a PatchFeatureStrategyWithResponse call
-func ParsePatchFeatureStrategyResponse(rsp *http.Response) (*PatchFeatureStrategyResponse, error) {
+// ParseToggleFeatureEnvironmentOffResponse parses an HTTP response from a ToggleFeatureEnvironmentOffWithResponse call
+func ParseToggleFeatureEnvironmentOffResponse(rsp *http.Response) (*ToggleFeatureEnvironmentOffResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchFeatureStrategyResponse{
+	response := &ToggleFeatureEnvironmentOffResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureStrategySchema
+		var dest FeatureSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -34709,43 +35731,27 @@ func ParsePatchFeatureStrategyResponse(rsp *http.Response) (*PatchFeatureStrateg
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON415 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseUpdateFeatureStrategyResponse parses an HTTP response from a UpdateFeatureStrategyWithResponse call
-func ParseUpdateFeatureStrategyResponse(rsp *http.Response) (*UpdateFeatureStrategyResponse, error) {
+// ParseToggleFeatureEnvironmentOnResponse parses an HTTP response from a ToggleFeatureEnvironmentOnWithResponse call
+func ParseToggleFeatureEnvironmentOnResponse(rsp *http.Response) (*ToggleFeatureEnvironmentOnResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateFeatureStrategyResponse{
+	response := &ToggleFeatureEnvironmentOnResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureStrategySchema
+		var dest FeatureSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -34815,43 +35821,27 @@ func ParseUpdateFeatureStrategyResponse(rsp *http.Response) (*UpdateFeatureStrat
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON415 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseGetEnvironmentFeatureVariantsResponse parses an HTTP response from a GetEnvironmentFeatureVariantsWithResponse call
-func ParseGetEnvironmentFeatureVariantsResponse(rsp *http.Response) (*GetEnvironmentFeatureVariantsResponse, error) {
+// ParseGetFeatureStrategiesResponse parses an HTTP response from a GetFeatureStrategiesWithResponse call
+func ParseGetFeatureStrategiesResponse(rsp *http.Response) (*GetFeatureStrategiesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEnvironmentFeatureVariantsResponse{
+	response := &GetFeatureStrategiesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureVariantsSchema
+		var dest FeatureStrategiesSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -34910,43 +35900,27 @@ func ParseGetEnvironmentFeatureVariantsResponse(rsp *http.Response) (*GetEnviron
 	return response, nil
 }
 
-// ParsePatchEnvironmentsFeatureVariantsResponse parses an HTTP response from a PatchEnvironmentsFeatureVariantsWithResponse call
-func ParsePatchEnvironmentsFeatureVariantsResponse(rsp *http.Response) (*PatchEnvironmentsFeatureVariantsResponse, error) {
+// ParseAddFeatureStrategyResponse parses an HTTP response from a AddFeatureStrategyWithResponse call
+func ParseAddFeatureStrategyResponse(rsp *http.Response) (*AddFeatureStrategyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchEnvironmentsFeatureVariantsResponse{
+	response := &AddFeatureStrategyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureVariantsSchema
+		var dest FeatureStrategySchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -35000,27 +35974,20 @@ func ParsePatchEnvironmentsFeatureVariantsResponse(rsp *http.Response) (*PatchEn
 	return response, nil
 }
 
-// ParseOverwriteEnvironmentFeatureVariantsResponse parses an HTTP response from a OverwriteEnvironmentFeatureVariantsWithResponse call
-func ParseOverwriteEnvironmentFeatureVariantsResponse(rsp *http.Response) (*OverwriteEnvironmentFeatureVariantsResponse, error) {
+// ParseSetStrategySortOrderResponse parses an HTTP response from a SetStrategySortOrderWithResponse call
+func ParseSetStrategySortOrderResponse(rsp *http.Response) (*SetStrategySortOrderResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &OverwriteEnvironmentFeatureVariantsResponse{
+	response := &SetStrategySortOrderResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureVariantsSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			// Id The ID of the error instance
@@ -35074,15 +36041,15 @@ func ParseOverwriteEnvironmentFeatureVariantsResponse(rsp *http.Response) (*Over
 	return response, nil
 }
 
-// ParseRemoveFavoriteFeatureResponse parses an HTTP response from a RemoveFavoriteFeatureWithResponse call
-func ParseRemoveFavoriteFeatureResponse(rsp *http.Response) (*RemoveFavoriteFeatureResponse, error) {
+// ParseDeleteFeatureStrategyResponse parses an HTTP response from a DeleteFeatureStrategyWithResponse call
+func ParseDeleteFeatureStrategyResponse(rsp *http.Response) (*DeleteFeatureStrategyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RemoveFavoriteFeatureResponse{
+	response := &DeleteFeatureStrategyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -35104,42 +36071,7 @@ func ParseRemoveFavoriteFeatureResponse(rsp *http.Response) (*RemoveFavoriteFeat
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseAddFavoriteFeatureResponse parses an HTTP response from a AddFavoriteFeatureWithResponse call
-func ParseAddFavoriteFeatureResponse(rsp *http.Response) (*AddFavoriteFeatureResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &AddFavoriteFeatureResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -35153,7 +36085,7 @@ func ParseAddFavoriteFeatureResponse(rsp *http.Response) (*AddFavoriteFeatureRes
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
@@ -35176,22 +36108,22 @@ func ParseAddFavoriteFeatureResponse(rsp *http.Response) (*AddFavoriteFeatureRes
 	return response, nil
 }
 
-// ParseListParentOptionsResponse parses an HTTP response from a ListParentOptionsWithResponse call
-func ParseListParentOptionsResponse(rsp *http.Response) (*ListParentOptionsResponse, error) {
+// ParseGetFeatureStrategyResponse parses an HTTP response from a GetFeatureStrategyWithResponse call
+func ParseGetFeatureStrategyResponse(rsp *http.Response) (*GetFeatureStrategyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListParentOptionsResponse{
+	response := &GetFeatureStrategyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ParentFeatureOptionsSchema
+		var dest FeatureStrategySchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -35250,27 +36182,43 @@ func ParseListParentOptionsResponse(rsp *http.Response) (*ListParentOptionsRespo
 	return response, nil
 }
 
-// ParseGetFeatureVariantsResponse parses an HTTP response from a GetFeatureVariantsWithResponse call
-func ParseGetFeatureVariantsResponse(rsp *http.Response) (*GetFeatureVariantsResponse, error) {
+// ParsePatchFeatureStrategyResponse parses an HTTP response from a PatchFeatureStrategyWithResponse call
+func ParsePatchFeatureStrategyResponse(rsp *http.Response) (*PatchFeatureStrategyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFeatureVariantsResponse{
+	response := &PatchFeatureStrategyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureVariantsSchema
+		var dest FeatureStrategySchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -35319,27 +36267,43 @@ func ParseGetFeatureVariantsResponse(rsp *http.Response) (*GetFeatureVariantsRes
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParsePatchFeatureVariantsResponse parses an HTTP response from a PatchFeatureVariantsWithResponse call
-func ParsePatchFeatureVariantsResponse(rsp *http.Response) (*PatchFeatureVariantsResponse, error) {
+// ParseUpdateFeatureStrategyResponse parses an HTTP response from a UpdateFeatureStrategyWithResponse call
+func ParseUpdateFeatureStrategyResponse(rsp *http.Response) (*UpdateFeatureStrategyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchFeatureVariantsResponse{
+	response := &UpdateFeatureStrategyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FeatureVariantsSchema
+		var dest FeatureStrategySchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -35409,20 +36373,36 @@ func ParsePatchFeatureVariantsResponse(rsp *http.Response) (*PatchFeatureVariant
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseOverwriteFeatureVariantsResponse parses an HTTP response from a OverwriteFeatureVariantsWithResponse call
-func ParseOverwriteFeatureVariantsResponse(rsp *http.Response) (*OverwriteFeatureVariantsResponse, error) {
+// ParseGetEnvironmentFeatureVariantsResponse parses an HTTP response from a GetEnvironmentFeatureVariantsWithResponse call
+func ParseGetEnvironmentFeatureVariantsResponse(rsp *http.Response) (*GetEnvironmentFeatureVariantsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &OverwriteFeatureVariantsResponse{
+	response := &GetEnvironmentFeatureVariantsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -35435,22 +36415,6 @@ func ParseOverwriteFeatureVariantsResponse(rsp *http.Response) (*OverwriteFeatur
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -35504,15 +36468,15 @@ func ParseOverwriteFeatureVariantsResponse(rsp *http.Response) (*OverwriteFeatur
 	return response, nil
 }
 
-// ParseOverwriteFeatureVariantsOnEnvironmentsResponse parses an HTTP response from a OverwriteFeatureVariantsOnEnvironmentsWithResponse call
-func ParseOverwriteFeatureVariantsOnEnvironmentsResponse(rsp *http.Response) (*OverwriteFeatureVariantsOnEnvironmentsResponse, error) {
+// ParsePatchEnvironmentsFeatureVariantsResponse parses an HTTP response from a PatchEnvironmentsFeatureVariantsWithResponse call
+func ParsePatchEnvironmentsFeatureVariantsResponse(rsp *http.Response) (*PatchEnvironmentsFeatureVariantsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &OverwriteFeatureVariantsOnEnvironmentsResponse{
+	response := &PatchEnvironmentsFeatureVariantsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -35573,64 +36537,6 @@ func ParseOverwriteFeatureVariantsOnEnvironmentsResponse(rsp *http.Response) (*O
 		}
 		response.JSON403 = &dest
 
-	}
-
-	return response, nil
-}
-
-// ParseGetProjectHealthReportResponse parses an HTTP response from a GetProjectHealthReportWithResponse call
-func ParseGetProjectHealthReportResponse(rsp *http.Response) (*GetProjectHealthReportResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &GetProjectHealthReportResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest HealthReportSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
@@ -35652,28 +36558,28 @@ func ParseGetProjectHealthReportResponse(rsp *http.Response) (*GetProjectHealthR
 	return response, nil
 }
 
-// ParseGetProjectOverviewResponse parses an HTTP response from a GetProjectOverviewWithResponse call
-func ParseGetProjectOverviewResponse(rsp *http.Response) (*GetProjectOverviewResponse, error) {
+// ParseOverwriteEnvironmentFeatureVariantsResponse parses an HTTP response from a OverwriteEnvironmentFeatureVariantsWithResponse call
+func ParseOverwriteEnvironmentFeatureVariantsResponse(rsp *http.Response) (*OverwriteEnvironmentFeatureVariantsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectOverviewResponse{
+	response := &OverwriteEnvironmentFeatureVariantsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectOverviewSchema
+		var dest FeatureVariantsSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -35687,9 +36593,9 @@ func ParseGetProjectOverviewResponse(rsp *http.Response) (*GetProjectOverviewRes
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -35703,9 +36609,9 @@ func ParseGetProjectOverviewResponse(rsp *http.Response) (*GetProjectOverviewRes
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -35719,43 +36625,27 @@ func ParseGetProjectOverviewResponse(rsp *http.Response) (*GetProjectOverviewRes
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseReviveFeaturesResponse parses an HTTP response from a ReviveFeaturesWithResponse call
-func ParseReviveFeaturesResponse(rsp *http.Response) (*ReviveFeaturesResponse, error) {
+// ParseRemoveFavoriteFeatureResponse parses an HTTP response from a RemoveFavoriteFeatureWithResponse call
+func ParseRemoveFavoriteFeatureResponse(rsp *http.Response) (*RemoveFavoriteFeatureResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ReviveFeaturesResponse{
+	response := &RemoveFavoriteFeatureResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -35772,7 +36662,7 @@ func ParseReviveFeaturesResponse(rsp *http.Response) (*ReviveFeaturesResponse, e
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -35786,22 +36676,22 @@ func ParseReviveFeaturesResponse(rsp *http.Response) (*ReviveFeaturesResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseStaleFeaturesResponse parses an HTTP response from a StaleFeaturesWithResponse call
-func ParseStaleFeaturesResponse(rsp *http.Response) (*StaleFeaturesResponse, error) {
+// ParseAddFavoriteFeatureResponse parses an HTTP response from a AddFavoriteFeatureWithResponse call
+func ParseAddFavoriteFeatureResponse(rsp *http.Response) (*AddFavoriteFeatureResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StaleFeaturesResponse{
+	response := &AddFavoriteFeatureResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -35823,23 +36713,7 @@ func ParseStaleFeaturesResponse(rsp *http.Response) (*StaleFeaturesResponse, err
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -35853,27 +36727,34 @@ func ParseStaleFeaturesResponse(rsp *http.Response) (*StaleFeaturesResponse, err
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON415 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseAddTagToFeaturesResponse parses an HTTP response from a AddTagToFeaturesWithResponse call
-func ParseAddTagToFeaturesResponse(rsp *http.Response) (*AddTagToFeaturesResponse, error) {
+// ParseListParentOptionsResponse parses an HTTP response from a ListParentOptionsWithResponse call
+func ParseListParentOptionsResponse(rsp *http.Response) (*ListParentOptionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddTagToFeaturesResponse{
+	response := &ListParentOptionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ParentFeatureOptionsSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -35922,43 +36803,27 @@ func ParseAddTagToFeaturesResponse(rsp *http.Response) (*AddTagToFeaturesRespons
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON415 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseSearchFeaturesResponse parses an HTTP response from a SearchFeaturesWithResponse call
-func ParseSearchFeaturesResponse(rsp *http.Response) (*SearchFeaturesResponse, error) {
+// ParseGetFeatureVariantsResponse parses an HTTP response from a GetFeatureVariantsWithResponse call
+func ParseGetFeatureVariantsResponse(rsp *http.Response) (*GetFeatureVariantsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SearchFeaturesResponse{
+	response := &GetFeatureVariantsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SearchFeaturesSchema
+		var dest FeatureVariantsSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -36017,53 +36882,27 @@ func ParseSearchFeaturesResponse(rsp *http.Response) (*SearchFeaturesResponse, e
 	return response, nil
 }
 
-// ParseGetSegmentsResponse parses an HTTP response from a GetSegmentsWithResponse call
-func ParseGetSegmentsResponse(rsp *http.Response) (*GetSegmentsResponse, error) {
+// ParsePatchFeatureVariantsResponse parses an HTTP response from a PatchFeatureVariantsWithResponse call
+func ParsePatchFeatureVariantsResponse(rsp *http.Response) (*PatchFeatureVariantsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetSegmentsResponse{
+	response := &PatchFeatureVariantsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SegmentsSchema
+		var dest FeatureVariantsSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	}
-
-	return response, nil
-}
-
-// ParseCreateSegmentResponse parses an HTTP response from a CreateSegmentWithResponse call
-func ParseCreateSegmentResponse(rsp *http.Response) (*CreateSegmentResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &CreateSegmentResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest AdminSegmentSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			// Id The ID of the error instance
@@ -36112,23 +36951,7 @@ func ParseCreateSegmentResponse(rsp *http.Response) (*CreateSegmentResponse, err
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -36142,33 +36965,33 @@ func ParseCreateSegmentResponse(rsp *http.Response) (*CreateSegmentResponse, err
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON415 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUpdateFeatureStrategySegmentsResponse parses an HTTP response from a UpdateFeatureStrategySegmentsWithResponse call
-func ParseUpdateFeatureStrategySegmentsResponse(rsp *http.Response) (*UpdateFeatureStrategySegmentsResponse, error) {
+// ParseOverwriteFeatureVariantsResponse parses an HTTP response from a OverwriteFeatureVariantsWithResponse call
+func ParseOverwriteFeatureVariantsResponse(rsp *http.Response) (*OverwriteFeatureVariantsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateFeatureStrategySegmentsResponse{
+	response := &OverwriteFeatureVariantsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest UpdateFeatureStrategySegmentsSchema
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeatureVariantsSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
@@ -36218,7 +37041,7 @@ func ParseUpdateFeatureStrategySegmentsResponse(rsp *http.Response) (*UpdateFeat
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -36232,53 +37055,34 @@ func ParseUpdateFeatureStrategySegmentsResponse(rsp *http.Response) (*UpdateFeat
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON415 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetSegmentsByStrategyIdResponse parses an HTTP response from a GetSegmentsByStrategyIdWithResponse call
-func ParseGetSegmentsByStrategyIdResponse(rsp *http.Response) (*GetSegmentsByStrategyIdResponse, error) {
+// ParseOverwriteFeatureVariantsOnEnvironmentsResponse parses an HTTP response from a OverwriteFeatureVariantsOnEnvironmentsWithResponse call
+func ParseOverwriteFeatureVariantsOnEnvironmentsResponse(rsp *http.Response) (*OverwriteFeatureVariantsOnEnvironmentsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetSegmentsByStrategyIdResponse{
+	response := &OverwriteFeatureVariantsOnEnvironmentsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SegmentsSchema
+		var dest FeatureVariantsSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	}
-
-	return response, nil
-}
-
-// ParseValidateSegmentResponse parses an HTTP response from a ValidateSegmentWithResponse call
-func ParseValidateSegmentResponse(rsp *http.Response) (*ValidateSegmentResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &ValidateSegmentResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			// Id The ID of the error instance
@@ -36311,23 +37115,7 @@ func ParseValidateSegmentResponse(rsp *http.Response) (*ValidateSegmentResponse,
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -36341,27 +37129,34 @@ func ParseValidateSegmentResponse(rsp *http.Response) (*ValidateSegmentResponse,
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON415 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseRemoveSegmentResponse parses an HTTP response from a RemoveSegmentWithResponse call
-func ParseRemoveSegmentResponse(rsp *http.Response) (*RemoveSegmentResponse, error) {
+// ParseGetProjectHealthReportResponse parses an HTTP response from a GetProjectHealthReportWithResponse call
+func ParseGetProjectHealthReportResponse(rsp *http.Response) (*GetProjectHealthReportResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RemoveSegmentResponse{
+	response := &GetProjectHealthReportResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthReportSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -36394,7 +37189,7 @@ func ParseRemoveSegmentResponse(rsp *http.Response) (*RemoveSegmentResponse, err
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -36408,35 +37203,35 @@ func ParseRemoveSegmentResponse(rsp *http.Response) (*RemoveSegmentResponse, err
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetSegmentResponse parses an HTTP response from a GetSegmentWithResponse call
-func ParseGetSegmentResponse(rsp *http.Response) (*GetSegmentResponse, error) {
+// ParseGetProjectOverviewResponse parses an HTTP response from a GetProjectOverviewWithResponse call
+func ParseGetProjectOverviewResponse(rsp *http.Response) (*GetProjectOverviewResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetSegmentResponse{
+	response := &GetProjectOverviewResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AdminSegmentSchema
+		var dest ProjectOverviewSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -36450,28 +37245,9 @@ func ParseGetSegmentResponse(rsp *http.Response) (*GetSegmentResponse, error) {
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseUpdateSegmentResponse parses an HTTP response from a UpdateSegmentWithResponse call
-func ParseUpdateSegmentResponse(rsp *http.Response) (*UpdateSegmentResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &UpdateSegmentResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+		response.JSON401 = &dest
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -36485,9 +37261,9 @@ func ParseUpdateSegmentResponse(rsp *http.Response) (*UpdateSegmentResponse, err
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -36501,9 +37277,28 @@ func ParseUpdateSegmentResponse(rsp *http.Response) (*UpdateSegmentResponse, err
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	}
+
+	return response, nil
+}
+
+// ParseReviveFeaturesResponse parses an HTTP response from a ReviveFeaturesWithResponse call
+func ParseReviveFeaturesResponse(rsp *http.Response) (*ReviveFeaturesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReviveFeaturesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -36517,9 +37312,9 @@ func ParseUpdateSegmentResponse(rsp *http.Response) (*UpdateSegmentResponse, err
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -36533,9 +37328,9 @@ func ParseUpdateSegmentResponse(rsp *http.Response) (*UpdateSegmentResponse, err
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -36549,76 +37344,27 @@ func ParseUpdateSegmentResponse(rsp *http.Response) (*UpdateSegmentResponse, err
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON415 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseGetStrategiesBySegmentIdResponse parses an HTTP response from a GetStrategiesBySegmentIdWithResponse call
-func ParseGetStrategiesBySegmentIdResponse(rsp *http.Response) (*GetStrategiesBySegmentIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &GetStrategiesBySegmentIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SegmentStrategiesSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUpdateSplashSettingsResponse parses an HTTP response from a UpdateSplashSettingsWithResponse call
-func ParseUpdateSplashSettingsResponse(rsp *http.Response) (*UpdateSplashSettingsResponse, error) {
+// ParseStaleFeaturesResponse parses an HTTP response from a StaleFeaturesWithResponse call
+func ParseStaleFeaturesResponse(rsp *http.Response) (*StaleFeaturesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateSplashSettingsResponse{
+	response := &StaleFeaturesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SplashResponseSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -36672,70 +37418,69 @@ func ParseUpdateSplashSettingsResponse(rsp *http.Response) (*UpdateSplashSetting
 	return response, nil
 }
 
-// ParseExportResponse parses an HTTP response from a ExportWithResponse call
-func ParseExportResponse(rsp *http.Response) (*ExportResponse, error) {
+// ParseAddTagToFeaturesResponse parses an HTTP response from a AddTagToFeaturesWithResponse call
+func ParseAddTagToFeaturesResponse(rsp *http.Response) (*AddTagToFeaturesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ExportResponse{
+	response := &AddTagToFeaturesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StateSchema
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
-
-	}
-
-	return response, nil
-}
+		response.JSON401 = &dest
 
-// ParseImportResponse parses an HTTP response from a ImportWithResponse call
-func ParseImportResponse(rsp *http.Response) (*ImportResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
 
-	response := &ImportResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
 
-	return response, nil
-}
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-// ParseGetAllStrategiesResponse parses an HTTP response from a GetAllStrategiesWithResponse call
-func ParseGetAllStrategiesResponse(rsp *http.Response) (*GetAllStrategiesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
 
-	response := &GetAllStrategiesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StrategiesSchema
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -36749,33 +37494,33 @@ func ParseGetAllStrategiesResponse(rsp *http.Response) (*GetAllStrategiesRespons
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON415 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseCreateStrategyResponse parses an HTTP response from a CreateStrategyWithResponse call
-func ParseCreateStrategyResponse(rsp *http.Response) (*CreateStrategyResponse, error) {
+// ParseSearchFeaturesResponse parses an HTTP response from a SearchFeaturesWithResponse call
+func ParseSearchFeaturesResponse(rsp *http.Response) (*SearchFeaturesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateStrategyResponse{
+	response := &SearchFeaturesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest StrategySchema
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SearchFeaturesSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
@@ -36809,7 +37554,7 @@ func ParseCreateStrategyResponse(rsp *http.Response) (*CreateStrategyResponse, e
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -36823,44 +37568,61 @@ func ParseCreateStrategyResponse(rsp *http.Response) (*CreateStrategyResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
+	}
 
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
+	return response, nil
+}
 
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
+// ParseGetSegmentsResponse parses an HTTP response from a GetSegmentsWithResponse call
+func ParseGetSegmentsResponse(rsp *http.Response) (*GetSegmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSegmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SegmentsSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON415 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseRemoveStrategyResponse parses an HTTP response from a RemoveStrategyWithResponse call
-func ParseRemoveStrategyResponse(rsp *http.Response) (*RemoveStrategyResponse, error) {
+// ParseCreateSegmentResponse parses an HTTP response from a CreateSegmentWithResponse call
+func ParseCreateSegmentResponse(rsp *http.Response) (*CreateSegmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RemoveStrategyResponse{
+	response := &CreateSegmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AdminSegmentSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -36874,9 +37636,9 @@ func ParseRemoveStrategyResponse(rsp *http.Response) (*RemoveStrategyResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -36890,9 +37652,9 @@ func ParseRemoveStrategyResponse(rsp *http.Response) (*RemoveStrategyResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -36906,35 +37668,9 @@ func ParseRemoveStrategyResponse(rsp *http.Response) (*RemoveStrategyResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseGetStrategyResponse parses an HTTP response from a GetStrategyWithResponse call
-func ParseGetStrategyResponse(rsp *http.Response) (*GetStrategyResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &GetStrategyResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StrategySchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -36948,9 +37684,9 @@ func ParseGetStrategyResponse(rsp *http.Response) (*GetStrategyResponse, error)
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -36964,28 +37700,35 @@ func ParseGetStrategyResponse(rsp *http.Response) (*GetStrategyResponse, error)
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON415 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUpdateStrategyResponse parses an HTTP response from a UpdateStrategyWithResponse call
-func ParseUpdateStrategyResponse(rsp *http.Response) (*UpdateStrategyResponse, error) {
+// ParseUpdateFeatureStrategySegmentsResponse parses an HTTP response from a UpdateFeatureStrategySegmentsWithResponse call
+func ParseUpdateFeatureStrategySegmentsResponse(rsp *http.Response) (*UpdateFeatureStrategySegmentsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateStrategyResponse{
+	response := &UpdateFeatureStrategySegmentsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest UpdateFeatureStrategySegmentsSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -36999,9 +37742,9 @@ func ParseUpdateStrategyResponse(rsp *http.Response) (*UpdateStrategyResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -37015,9 +37758,9 @@ func ParseUpdateStrategyResponse(rsp *http.Response) (*UpdateStrategyResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -37031,7 +37774,7 @@ func ParseUpdateStrategyResponse(rsp *http.Response) (*UpdateStrategyResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
 		var dest struct {
@@ -37054,20 +37797,62 @@ func ParseUpdateStrategyResponse(rsp *http.Response) (*UpdateStrategyResponse, e
 	return response, nil
 }
 
-// ParseDeprecateStrategyResponse parses an HTTP response from a DeprecateStrategyWithResponse call
-func ParseDeprecateStrategyResponse(rsp *http.Response) (*DeprecateStrategyResponse, error) {
+// ParseGetSegmentsByStrategyIdResponse parses an HTTP response from a GetSegmentsByStrategyIdWithResponse call
+func ParseGetSegmentsByStrategyIdResponse(rsp *http.Response) (*GetSegmentsByStrategyIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeprecateStrategyResponse{
+	response := &GetSegmentsByStrategyIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SegmentsSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseValidateSegmentResponse parses an HTTP response from a ValidateSegmentWithResponse call
+func ParseValidateSegmentResponse(rsp *http.Response) (*ValidateSegmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ValidateSegmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -37084,7 +37869,7 @@ func ParseDeprecateStrategyResponse(rsp *http.Response) (*DeprecateStrategyRespo
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -37098,9 +37883,9 @@ func ParseDeprecateStrategyResponse(rsp *http.Response) (*DeprecateStrategyRespo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -37114,22 +37899,22 @@ func ParseDeprecateStrategyResponse(rsp *http.Response) (*DeprecateStrategyRespo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON415 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseReactivateStrategyResponse parses an HTTP response from a ReactivateStrategyWithResponse call
-func ParseReactivateStrategyResponse(rsp *http.Response) (*ReactivateStrategyResponse, error) {
+// ParseRemoveSegmentResponse parses an HTTP response from a RemoveSegmentWithResponse call
+func ParseRemoveSegmentResponse(rsp *http.Response) (*RemoveSegmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ReactivateStrategyResponse{
+	response := &RemoveSegmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -37167,7 +37952,7 @@ func ParseReactivateStrategyResponse(rsp *http.Response) (*ReactivateStrategyRes
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -37181,51 +37966,35 @@ func ParseReactivateStrategyResponse(rsp *http.Response) (*ReactivateStrategyRes
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON409 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetTagTypesResponse parses an HTTP response from a GetTagTypesWithResponse call
-func ParseGetTagTypesResponse(rsp *http.Response) (*GetTagTypesResponse, error) {
+// ParseGetSegmentResponse parses an HTTP response from a GetSegmentWithResponse call
+func ParseGetSegmentResponse(rsp *http.Response) (*GetSegmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTagTypesResponse{
+	response := &GetSegmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TagTypesSchema
+		var dest AdminSegmentSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -37239,34 +38008,27 @@ func ParseGetTagTypesResponse(rsp *http.Response) (*GetTagTypesResponse, error)
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseCreateTagTypeResponse parses an HTTP response from a CreateTagTypeWithResponse call
-func ParseCreateTagTypeResponse(rsp *http.Response) (*CreateTagTypeResponse, error) {
+// ParseUpdateSegmentResponse parses an HTTP response from a UpdateSegmentWithResponse call
+func ParseUpdateSegmentResponse(rsp *http.Response) (*UpdateSegmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateTagTypeResponse{
+	response := &UpdateSegmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TagTypeSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			// Id The ID of the error instance
@@ -37352,22 +38114,48 @@ func ParseCreateTagTypeResponse(rsp *http.Response) (*CreateTagTypeResponse, err
 	return response, nil
 }
 
-// ParseValidateTagTypeResponse parses an HTTP response from a ValidateTagTypeWithResponse call
-func ParseValidateTagTypeResponse(rsp *http.Response) (*ValidateTagTypeResponse, error) {
+// ParseGetStrategiesBySegmentIdResponse parses an HTTP response from a GetStrategiesBySegmentIdWithResponse call
+func ParseGetStrategiesBySegmentIdResponse(rsp *http.Response) (*GetStrategiesBySegmentIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ValidateTagTypeResponse{
+	response := &GetStrategiesBySegmentIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ValidateTagTypeSchema
+		var dest SegmentStrategiesSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSplashSettingsResponse parses an HTTP response from a UpdateSplashSettingsWithResponse call
+func ParseUpdateSplashSettingsResponse(rsp *http.Response) (*UpdateSplashSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSplashSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SplashResponseSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -37421,7 +38209,7 @@ func ParseValidateTagTypeResponse(rsp *http.Response) (*ValidateTagTypeResponse,
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -37435,60 +38223,77 @@ func ParseValidateTagTypeResponse(rsp *http.Response) (*ValidateTagTypeResponse,
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON415 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
+	}
 
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
+	return response, nil
+}
 
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
+// ParseExportResponse parses an HTTP response from a ExportWithResponse call
+func ParseExportResponse(rsp *http.Response) (*ExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StateSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON415 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteTagTypeResponse parses an HTTP response from a DeleteTagTypeWithResponse call
-func ParseDeleteTagTypeResponse(rsp *http.Response) (*DeleteTagTypeResponse, error) {
+// ParseImportResponse parses an HTTP response from a ImportWithResponse call
+func ParseImportResponse(rsp *http.Response) (*ImportResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteTagTypeResponse{
+	response := &ImportResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
+	return response, nil
+}
 
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
+// ParseGetAllStrategiesResponse parses an HTTP response from a GetAllStrategiesWithResponse call
+func ParseGetAllStrategiesResponse(rsp *http.Response) (*GetAllStrategiesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
+	response := &GetAllStrategiesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StrategiesSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -37502,33 +38307,33 @@ func ParseDeleteTagTypeResponse(rsp *http.Response) (*DeleteTagTypeResponse, err
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON401 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetTagTypeResponse parses an HTTP response from a GetTagTypeWithResponse call
-func ParseGetTagTypeResponse(rsp *http.Response) (*GetTagTypeResponse, error) {
+// ParseCreateStrategyResponse parses an HTTP response from a CreateStrategyWithResponse call
+func ParseCreateStrategyResponse(rsp *http.Response) (*CreateStrategyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTagTypeResponse{
+	response := &CreateStrategyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TagTypeSchema
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest StrategySchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
@@ -37562,26 +38367,23 @@ func ParseGetTagTypeResponse(rsp *http.Response) (*GetTagTypeResponse, error) {
 		}
 		response.JSON403 = &dest
 
-	}
-
-	return response, nil
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
 
-// ParseUpdateTagTypeResponse parses an HTTP response from a UpdateTagTypeWithResponse call
-func ParseUpdateTagTypeResponse(rsp *http.Response) (*UpdateTagTypeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
 
-	response := &UpdateTagTypeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -37595,8 +38397,27 @@ func ParseUpdateTagTypeResponse(rsp *http.Response) (*UpdateTagTypeResponse, err
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON415 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveStrategyResponse parses an HTTP response from a RemoveStrategyWithResponse call
+func ParseRemoveStrategyResponse(rsp *http.Response) (*RemoveStrategyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveStrategyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
+	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -37629,7 +38450,7 @@ func ParseUpdateTagTypeResponse(rsp *http.Response) (*UpdateTagTypeResponse, err
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -37643,29 +38464,29 @@ func ParseUpdateTagTypeResponse(rsp *http.Response) (*UpdateTagTypeResponse, err
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON415 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetTagsResponse parses an HTTP response from a GetTagsWithResponse call
-func ParseGetTagsResponse(rsp *http.Response) (*GetTagsResponse, error) {
+// ParseGetStrategyResponse parses an HTTP response from a GetStrategyWithResponse call
+func ParseGetStrategyResponse(rsp *http.Response) (*GetStrategyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTagsResponse{
+	response := &GetStrategyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TagsSchema
+		var dest StrategySchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -37687,7 +38508,7 @@ func ParseGetTagsResponse(rsp *http.Response) (*GetTagsResponse, error) {
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -37701,50 +38522,27 @@ func ParseGetTagsResponse(rsp *http.Response) (*GetTagsResponse, error) {
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseCreateTagResponse parses an HTTP response from a CreateTagWithResponse call
-func ParseCreateTagResponse(rsp *http.Response) (*CreateTagResponse, error) {
+// ParseUpdateStrategyResponse parses an HTTP response from a UpdateStrategyWithResponse call
+func ParseUpdateStrategyResponse(rsp *http.Response) (*UpdateStrategyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateTagResponse{
+	response := &UpdateStrategyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TagWithVersionSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			// Id The ID of the error instance
-			Id *string `json:"id,omitempty"`
-
-			// Message A description of what went wrong.
-			Message *string `json:"message,omitempty"`
-
-			// Name The name of the error kind
-			Name *string `json:"name,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -37777,7 +38575,7 @@ func ParseCreateTagResponse(rsp *http.Response) (*CreateTagResponse, error) {
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -37791,7 +38589,7 @@ func ParseCreateTagResponse(rsp *http.Response) (*CreateTagResponse, error) {
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
 		var dest struct {
@@ -37814,27 +38612,20 @@ func ParseCreateTagResponse(rsp *http.Response) (*CreateTagResponse, error) {
 	return response, nil
 }
 
-// ParseGetTagsByTypeResponse parses an HTTP response from a GetTagsByTypeWithResponse call
-func ParseGetTagsByTypeResponse(rsp *http.Response) (*GetTagsByTypeResponse, error) {
+// ParseDeprecateStrategyResponse parses an HTTP response from a DeprecateStrategyWithResponse call
+func ParseDeprecateStrategyResponse(rsp *http.Response) (*DeprecateStrategyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTagsByTypeResponse{
+	response := &DeprecateStrategyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TagsSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -37867,48 +38658,41 @@ func ParseGetTagsByTypeResponse(rsp *http.Response) (*GetTagsByTypeResponse, err
 		}
 		response.JSON403 = &dest
 
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
 
-	return response, nil
-}
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
 
-// ParseDeleteTagResponse parses an HTTP response from a DeleteTagWithResponse call
-func ParseDeleteTagResponse(rsp *http.Response) (*DeleteTagResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	response := &DeleteTagResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseGetTagResponse parses an HTTP response from a GetTagWithResponse call
-func ParseGetTagResponse(rsp *http.Response) (*GetTagResponse, error) {
+// ParseReactivateStrategyResponse parses an HTTP response from a ReactivateStrategyWithResponse call
+func ParseReactivateStrategyResponse(rsp *http.Response) (*ReactivateStrategyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTagResponse{
+	response := &ReactivateStrategyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TagWithVersionSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			// Id The ID of the error instance
@@ -37962,94 +38746,100 @@ func ParseGetTagResponse(rsp *http.Response) (*GetTagResponse, error) {
 	return response, nil
 }
 
-// ParseGetTelemetrySettingsResponse parses an HTTP response from a GetTelemetrySettingsWithResponse call
-func ParseGetTelemetrySettingsResponse(rsp *http.Response) (*GetTelemetrySettingsResponse, error) {
+// ParseGetTagTypesResponse parses an HTTP response from a GetTagTypesWithResponse call
+func ParseGetTagTypesResponse(rsp *http.Response) (*GetTagTypesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTelemetrySettingsResponse{
+	response := &GetTagTypesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TelemetrySettingsSchema
+		var dest TagTypesSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
 
-	return response, nil
-}
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
 
-// ParseGetUiConfigResponse parses an HTTP response from a GetUiConfigWithResponse call
-func ParseGetUiConfigResponse(rsp *http.Response) (*GetUiConfigResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-	response := &GetUiConfigResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UiConfigSchema
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSetUiConfigResponse parses an HTTP response from a SetUiConfigWithResponse call
-func ParseSetUiConfigResponse(rsp *http.Response) (*SetUiConfigResponse, error) {
+// ParseCreateTagTypeResponse parses an HTTP response from a CreateTagTypeWithResponse call
+func ParseCreateTagTypeResponse(rsp *http.Response) (*CreateTagTypeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SetUiConfigResponse{
+	response := &CreateTagTypeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	return response, nil
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TagTypeSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// ParseGetMeResponse parses an HTTP response from a GetMeWithResponse call
-func ParseGetMeResponse(rsp *http.Response) (*GetMeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
 
-	response := &GetMeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MeSchema
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
@@ -38067,33 +38857,23 @@ func ParseGetMeResponse(rsp *http.Response) (*GetMeResponse, error) {
 		}
 		response.JSON401 = &dest
 
-	}
-
-	return response, nil
-}
-
-// ParseGetUsersResponse parses an HTTP response from a GetUsersWithResponse call
-func ParseGetUsersResponse(rsp *http.Response) (*GetUsersResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
 
-	response := &GetUsersResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UsersSchema
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -38107,9 +38887,9 @@ func ParseGetUsersResponse(rsp *http.Response) (*GetUsersResponse, error) {
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -38123,33 +38903,33 @@ func ParseGetUsersResponse(rsp *http.Response) (*GetUsersResponse, error) {
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON415 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseCreateUserResponse parses an HTTP response from a CreateUserWithResponse call
-func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
+// ParseValidateTagTypeResponse parses an HTTP response from a ValidateTagTypeWithResponse call
+func ParseValidateTagTypeResponse(rsp *http.Response) (*ValidateTagTypeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateUserResponse{
+	response := &ValidateTagTypeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CreateUserResponseSchema
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ValidateTagTypeSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
@@ -38199,33 +38979,74 @@ func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetBaseUsersAndGroupsResponse parses an HTTP response from a GetBaseUsersAndGroupsWithResponse call
-func ParseGetBaseUsersAndGroupsResponse(rsp *http.Response) (*GetBaseUsersAndGroupsResponse, error) {
+// ParseDeleteTagTypeResponse parses an HTTP response from a DeleteTagTypeWithResponse call
+func ParseDeleteTagTypeResponse(rsp *http.Response) (*DeleteTagTypeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBaseUsersAndGroupsResponse{
+	response := &DeleteTagTypeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UsersGroupsBaseSchema
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -38239,29 +39060,29 @@ func ParseGetBaseUsersAndGroupsResponse(rsp *http.Response) (*GetBaseUsersAndGro
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetAdminCountResponse parses an HTTP response from a GetAdminCountWithResponse call
-func ParseGetAdminCountResponse(rsp *http.Response) (*GetAdminCountResponse, error) {
+// ParseGetTagTypeResponse parses an HTTP response from a GetTagTypeWithResponse call
+func ParseGetTagTypeResponse(rsp *http.Response) (*GetTagTypeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAdminCountResponse{
+	response := &GetTagTypeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AdminCountSchema
+		var dest TagTypeSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -38304,27 +39125,20 @@ func ParseGetAdminCountResponse(rsp *http.Response) (*GetAdminCountResponse, err
 	return response, nil
 }
 
-// ParseResetUserPasswordResponse parses an HTTP response from a ResetUserPasswordWithResponse call
-func ParseResetUserPasswordResponse(rsp *http.Response) (*ResetUserPasswordResponse, error) {
+// ParseUpdateTagTypeResponse parses an HTTP response from a UpdateTagTypeWithResponse call
+func ParseUpdateTagTypeResponse(rsp *http.Response) (*UpdateTagTypeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ResetUserPasswordResponse{
+	response := &UpdateTagTypeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ResetPasswordSchema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest struct {
 			// Id The ID of the error instance
@@ -38373,7 +39187,7 @@ func ParseResetUserPasswordResponse(rsp *http.Response) (*ResetUserPasswordRespo
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
 		var dest struct {
 			// Id The ID of the error instance
 			Id *string `json:"id,omitempty"`
@@ -38387,29 +39201,29 @@ func ParseResetUserPasswordResponse(rsp *http.Response) (*ResetUserPasswordRespo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON415 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSearchUsersResponse parses an HTTP response from a SearchUsersWithResponse call
-func ParseSearchUsersResponse(rsp *http.Response) (*SearchUsersResponse, error) {
+// ParseGetTagsResponse parses an HTTP response from a GetTagsWithResponse call
+func ParseGetTagsResponse(rsp *http.Response) (*GetTagsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SearchUsersResponse{
+	response := &GetTagsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UsersSchema
+		var dest TagsSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -38431,20 +39245,764 @@ func ParseSearchUsersResponse(rsp *http.Response) (*SearchUsersResponse, error)
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseValidateUserPasswordResponse parses an HTTP response from a ValidateUserPasswordWithResponse call
-func ParseValidateUserPasswordResponse(rsp *http.Response) (*ValidateUserPasswordResponse, error) {
+// ParseCreateTagResponse parses an HTTP response from a CreateTagWithResponse call
+func ParseCreateTagResponse(rsp *http.Response) (*CreateTagResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ValidateUserPasswordResponse{
+	response := &CreateTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TagWithVersionSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTagsByTypeResponse parses an HTTP response from a GetTagsByTypeWithResponse call
+func ParseGetTagsByTypeResponse(rsp *http.Response) (*GetTagsByTypeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTagsByTypeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TagsSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTagResponse parses an HTTP response from a DeleteTagWithResponse call
+func ParseDeleteTagResponse(rsp *http.Response) (*DeleteTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetTagResponse parses an HTTP response from a GetTagWithResponse call
+func ParseGetTagResponse(rsp *http.Response) (*GetTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TagWithVersionSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTelemetrySettingsResponse parses an HTTP response from a GetTelemetrySettingsWithResponse call
+func ParseGetTelemetrySettingsResponse(rsp *http.Response) (*GetTelemetrySettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTelemetrySettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TelemetrySettingsSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUiConfigResponse parses an HTTP response from a GetUiConfigWithResponse call
+func ParseGetUiConfigResponse(rsp *http.Response) (*GetUiConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUiConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UiConfigSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetUiConfigResponse parses an HTTP response from a SetUiConfigWithResponse call
+func ParseSetUiConfigResponse(rsp *http.Response) (*SetUiConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetUiConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetMeResponse parses an HTTP response from a GetMeWithResponse call
+func ParseGetMeResponse(rsp *http.Response) (*GetMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MeSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersResponse parses an HTTP response from a GetUsersWithResponse call
+func ParseGetUsersResponse(rsp *http.Response) (*GetUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UsersSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUserResponse parses an HTTP response from a CreateUserWithResponse call
+func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateUserResponseSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBaseUsersAndGroupsResponse parses an HTTP response from a GetBaseUsersAndGroupsWithResponse call
+func ParseGetBaseUsersAndGroupsResponse(rsp *http.Response) (*GetBaseUsersAndGroupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBaseUsersAndGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UsersGroupsBaseSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminCountResponse parses an HTTP response from a GetAdminCountWithResponse call
+func ParseGetAdminCountResponse(rsp *http.Response) (*GetAdminCountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminCountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminCountSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResetUserPasswordResponse parses an HTTP response from a ResetUserPasswordWithResponse call
+func ParseResetUserPasswordResponse(rsp *http.Response) (*ResetUserPasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResetUserPasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ResetPasswordSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchUsersResponse parses an HTTP response from a SearchUsersWithResponse call
+func ParseSearchUsersResponse(rsp *http.Response) (*SearchUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UsersSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			// Id The ID of the error instance
+			Id *string `json:"id,omitempty"`
+
+			// Message A description of what went wrong.
+			Message *string `json:"message,omitempty"`
+
+			// Name The name of the error kind
+			Name *string `json:"name,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseValidateUserPasswordResponse parses an HTTP response from a ValidateUserPasswordWithResponse call
+func ParseValidateUserPasswordResponse(rsp *http.Response) (*ValidateUserPasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ValidateUserPasswordResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -39998,12 +41556,21 @@ type ServerInterface interface {
 	// Get all environments
 	// (GET /api/admin/environments)
 	GetAllEnvironments(c *gin.Context)
+	// Creates a new environment
+	// (POST /api/admin/environments)
+	CreateEnvironment(c *gin.Context)
 	// Get the environments available to a project
 	// (GET /api/admin/environments/project/{projectId})
 	GetProjectEnvironments(c *gin.Context, projectId string)
 	// Update environment sort orders
 	// (PUT /api/admin/environments/sort-order)
 	UpdateSortOrder(c *gin.Context)
+	// Updates an environment by name
+	// (PUT /api/admin/environments/update/{name})
+	UpdateEnvironment(c *gin.Context, name string)
+	// Deletes an environment by name
+	// (DELETE /api/admin/environments/{name})
+	RemoveEnvironment(c *gin.Context, name string)
 	// Get the environment with `name`
 	// (GET /api/admin/environments/{name})
 	GetEnvironment(c *gin.Context, name string)
@@ -40106,9 +41673,18 @@ type ServerInterface interface {
 	// Get a list of all projects.
 	// (GET /api/admin/projects)
 	GetProjects(c *gin.Context)
+	// Create project
+	// (POST /api/admin/projects)
+	CreateProject(c *gin.Context)
+	// Delete project
+	// (DELETE /api/admin/projects/{projectId})
+	DeleteProject(c *gin.Context, projectId string)
 	// Get an overview of a project. (deprecated)
 	// (GET /api/admin/projects/{projectId})
 	GetDeprecatedProjectOverview(c *gin.Context, projectId string)
+	// Update project
+	// (PUT /api/admin/projects/{projectId})
+	UpdateProject(c *gin.Context, projectId string)
 	// Get api tokens for project.
 	// (GET /api/admin/projects/{projectId}/api-tokens)
 	GetProjectApiTokens(c *gin.Context, projectId string)
@@ -41014,6 +42590,21 @@ func (siw *ServerInterfaceWrapper) GetAllEnvironments(c *gin.Context) {
 	siw.Handler.GetAllEnvironments(c)
 }
 
+// CreateEnvironment operation middleware
+func (siw *ServerInterfaceWrapper) CreateEnvironment(c *gin.Context) {
+
+	c.Set(ApiKeyScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateEnvironment(c)
+}
+
 // GetProjectEnvironments operation middleware
 func (siw *ServerInterfaceWrapper) GetProjectEnvironments(c *gin.Context) {
 
@@ -41055,6 +42646,58 @@ func (siw *ServerInterfaceWrapper) UpdateSortOrder(c *gin.Context) {
 	siw.Handler.UpdateSortOrder(c)
 }
 
+// UpdateEnvironment operation middleware
+func (siw *ServerInterfaceWrapper) UpdateEnvironment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateEnvironment(c, name)
+}
+
+// RemoveEnvironment operation middleware
+func (siw *ServerInterfaceWrapper) RemoveEnvironment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RemoveEnvironment(c, name)
+}
+
 // GetEnvironment operation middleware
 func (siw *ServerInterfaceWrapper) GetEnvironment(c *gin.Context) {
 
@@ -41761,6 +43404,47 @@ func (siw *ServerInterfaceWrapper) GetProjects(c *gin.Context) {
 	siw.Handler.GetProjects(c)
 }
 
+// CreateProject operation middleware
+func (siw *ServerInterfaceWrapper) CreateProject(c *gin.Context) {
+
+	c.Set(ApiKeyScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateProject(c)
+}
+
+// DeleteProject operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProject(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", c.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter projectId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteProject(c, projectId)
+}
+
 // GetDeprecatedProjectOverview operation middleware
 func (siw *ServerInterfaceWrapper) GetDeprecatedProjectOverview(c *gin.Context) {
 
@@ -41787,6 +43471,32 @@ func (siw *ServerInterfaceWrapper) GetDeprecatedProjectOverview(c *gin.Context)
 	siw.Handler.GetDeprecatedProjectOverview(c, projectId)
 }
 
+// UpdateProject operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProject(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", c.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter projectId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateProject(c, projectId)
+}
+
 // GetProjectApiTokens operation middleware
 func (siw *ServerInterfaceWrapper) GetProjectApiTokens(c *gin.Context) {
 
@@ -45092,8 +46802,11 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.PUT(options.BaseURL+"/api/admin/context/:contextField", wrapper.UpdateContextField)
 	router.GET(options.BaseURL+"/api/admin/context/:contextField/strategies", wrapper.GetStrategiesByContextField)
 	router.GET(options.BaseURL+"/api/admin/environments", wrapper.GetAllEnvironments)
+	router.POST(options.BaseURL+"/api/admin/environments", wrapper.CreateEnvironment)
 	router.GET(options.BaseURL+"/api/admin/environments/project/:projectId", wrapper.GetProjectEnvironments)
 	router.PUT(options.BaseURL+"/api/admin/environments/sort-order", wrapper.UpdateSortOrder)
+	router.PUT(options.BaseURL+"/api/admin/environments/update/:name", wrapper.UpdateEnvironment)
+	router.DELETE(options.BaseURL+"/api/admin/environments/:name", wrapper.RemoveEnvironment)
 	router.GET(options.BaseURL+"/api/admin/environments/:name", wrapper.GetEnvironment)
 	router.POST(options.BaseURL+"/api/admin/environments/:name/off", wrapper.ToggleEnvironmentOff)
 	router.POST(options.BaseURL+"/api/admin/environments/:name/on", wrapper.ToggleEnvironmentOn)
@@ -45128,7 +46841,10 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/api/admin/playground", wrapper.GetPlayground)
 	router.POST(options.BaseURL+"/api/admin/playground/advanced", wrapper.GetAdvancedPlayground)
 	router.GET(options.BaseURL+"/api/admin/projects", wrapper.GetProjects)
+	router.POST(options.BaseURL+"/api/admin/projects", wrapper.CreateProject)
+	router.DELETE(options.BaseURL+"/api/admin/projects/:projectId", wrapper.DeleteProject)
 	router.GET(options.BaseURL+"/api/admin/projects/:projectId", wrapper.GetDeprecatedProjectOverview)
+	router.PUT(options.BaseURL+"/api/admin/projects/:projectId", wrapper.UpdateProject)
 	router.GET(options.BaseURL+"/api/admin/projects/:projectId/api-tokens", wrapper.GetProjectApiTokens)
 	router.POST(options.BaseURL+"/api/admin/projects/:projectId/api-tokens", wrapper.CreateProjectApiToken)
 	router.DELETE(options.BaseURL+"/api/admin/projects/:projectId/api-tokens/:token", wrapper.DeleteProjectApiToken)
@@ -46579,6 +48295,95 @@ func (response GetAllEnvironments403JSONResponse) VisitGetAllEnvironmentsRespons
 	return json.NewEncoder(w).Encode(response)
 }
 
+type CreateEnvironmentRequestObject struct {
+	Body *CreateEnvironmentJSONRequestBody
+}
+
+type CreateEnvironmentResponseObject interface {
+	VisitCreateEnvironmentResponse(w http.ResponseWriter) error
+}
+
+type CreateEnvironment201JSONResponse EnvironmentSchema
+
+func (response CreateEnvironment201JSONResponse) VisitCreateEnvironmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEnvironment400JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response CreateEnvironment400JSONResponse) VisitCreateEnvironmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEnvironment401JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response CreateEnvironment401JSONResponse) VisitCreateEnvironmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEnvironment403JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response CreateEnvironment403JSONResponse) VisitCreateEnvironmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEnvironment409JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response CreateEnvironment409JSONResponse) VisitCreateEnvironmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetProjectEnvironmentsRequestObject struct {
 	ProjectId string `json:"projectId"`
 }
@@ -46720,6 +48525,184 @@ func (response UpdateSortOrder404JSONResponse) VisitUpdateSortOrderResponse(w ht
 	return json.NewEncoder(w).Encode(response)
 }
 
+type UpdateEnvironmentRequestObject struct {
+	Name string `json:"name"`
+	Body *UpdateEnvironmentJSONRequestBody
+}
+
+type UpdateEnvironmentResponseObject interface {
+	VisitUpdateEnvironmentResponse(w http.ResponseWriter) error
+}
+
+type UpdateEnvironment200JSONResponse EnvironmentSchema
+
+func (response UpdateEnvironment200JSONResponse) VisitUpdateEnvironmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEnvironment400JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response UpdateEnvironment400JSONResponse) VisitUpdateEnvironmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEnvironment401JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response UpdateEnvironment401JSONResponse) VisitUpdateEnvironmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEnvironment403JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response UpdateEnvironment403JSONResponse) VisitUpdateEnvironmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEnvironment404JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response UpdateEnvironment404JSONResponse) VisitUpdateEnvironmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveEnvironmentRequestObject struct {
+	Name string `json:"name"`
+}
+
+type RemoveEnvironmentResponseObject interface {
+	VisitRemoveEnvironmentResponse(w http.ResponseWriter) error
+}
+
+type RemoveEnvironment200Response struct {
+}
+
+func (response RemoveEnvironment200Response) VisitRemoveEnvironmentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type RemoveEnvironment400JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response RemoveEnvironment400JSONResponse) VisitRemoveEnvironmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveEnvironment401JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response RemoveEnvironment401JSONResponse) VisitRemoveEnvironmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveEnvironment403JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response RemoveEnvironment403JSONResponse) VisitRemoveEnvironmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveEnvironment404JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response RemoveEnvironment404JSONResponse) VisitRemoveEnvironmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetEnvironmentRequestObject struct {
 	Name string `json:"name"`
 }
@@ -48620,6 +50603,183 @@ func (response GetProjects403JSONResponse) VisitGetProjectsResponse(w http.Respo
 	return json.NewEncoder(w).Encode(response)
 }
 
+type CreateProjectRequestObject struct {
+	Body *CreateProjectJSONRequestBody
+}
+
+type CreateProjectResponseObject interface {
+	VisitCreateProjectResponse(w http.ResponseWriter) error
+}
+
+type CreateProject201JSONResponse ProjectCreatedSchema
+
+func (response CreateProject201JSONResponse) VisitCreateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProject400JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response CreateProject400JSONResponse) VisitCreateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProject401JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response CreateProject401JSONResponse) VisitCreateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProject403JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response CreateProject403JSONResponse) VisitCreateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProject409JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response CreateProject409JSONResponse) VisitCreateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProject415JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response CreateProject415JSONResponse) VisitCreateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(415)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectRequestObject struct {
+	ProjectId string `json:"projectId"`
+}
+
+type DeleteProjectResponseObject interface {
+	VisitDeleteProjectResponse(w http.ResponseWriter) error
+}
+
+type DeleteProject200Response struct {
+}
+
+func (response DeleteProject200Response) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteProject401JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response DeleteProject401JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProject403JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response DeleteProject403JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProject404JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response DeleteProject404JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetDeprecatedProjectOverviewRequestObject struct {
 	ProjectId string `json:"projectId"`
 }
@@ -48691,6 +50851,113 @@ func (response GetDeprecatedProjectOverview404JSONResponse) VisitGetDeprecatedPr
 	return json.NewEncoder(w).Encode(response)
 }
 
+type UpdateProjectRequestObject struct {
+	ProjectId string `json:"projectId"`
+	Body      *UpdateProjectJSONRequestBody
+}
+
+type UpdateProjectResponseObject interface {
+	VisitUpdateProjectResponse(w http.ResponseWriter) error
+}
+
+type UpdateProject200Response struct {
+}
+
+func (response UpdateProject200Response) VisitUpdateProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type UpdateProject400JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response UpdateProject400JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProject401JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response UpdateProject401JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProject403JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response UpdateProject403JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProject404JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response UpdateProject404JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProject415JSONResponse struct {
+	// Id The ID of the error instance
+	Id *string `json:"id,omitempty"`
+
+	// Message A description of what went wrong.
+	Message *string `json:"message,omitempty"`
+
+	// Name The name of the error kind
+	Name *string `json:"name,omitempty"`
+}
+
+func (response UpdateProject415JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(415)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetProjectApiTokensRequestObject struct {
 	ProjectId string `json:"projectId"`
 }
@@ -56607,12 +58874,21 @@ type StrictServerInterface interface {
 	// Get all environments
 	// (GET /api/admin/environments)
 	GetAllEnvironments(ctx context.Context, request GetAllEnvironmentsRequestObject) (GetAllEnvironmentsResponseObject, error)
+	// Creates a new environment
+	// (POST /api/admin/environments)
+	CreateEnvironment(ctx context.Context, request CreateEnvironmentRequestObject) (CreateEnvironmentResponseObject, error)
 	// Get the environments available to a project
 	// (GET /api/admin/environments/project/{projectId})
 	GetProjectEnvironments(ctx context.Context, request GetProjectEnvironmentsRequestObject) (GetProjectEnvironmentsResponseObject, error)
 	// Update environment sort orders
 	// (PUT /api/admin/environments/sort-order)
 	UpdateSortOrder(ctx context.Context, request UpdateSortOrderRequestObject) (UpdateSortOrderResponseObject, error)
+	// Updates an environment by name
+	// (PUT /api/admin/environments/update/{name})
+	UpdateEnvironment(ctx context.Context, request UpdateEnvironmentRequestObject) (UpdateEnvironmentResponseObject, error)
+	// Deletes an environment by name
+	// (DELETE /api/admin/environments/{name})
+	RemoveEnvironment(ctx context.Context, request RemoveEnvironmentRequestObject) (RemoveEnvironmentResponseObject, error)
 	// Get the environment with `name`
 	// (GET /api/admin/environments/{name})
 	GetEnvironment(ctx context.Context, request GetEnvironmentRequestObject) (GetEnvironmentResponseObject, error)
@@ -56715,9 +58991,18 @@ type StrictServerInterface interface {
 	// Get a list of all projects.
 	// (GET /api/admin/projects)
 	GetProjects(ctx context.Context, request GetProjectsRequestObject) (GetProjectsResponseObject, error)
+	// Create project
+	// (POST /api/admin/projects)
+	CreateProject(ctx context.Context, request CreateProjectRequestObject) (CreateProjectResponseObject, error)
+	// Delete project
+	// (DELETE /api/admin/projects/{projectId})
+	DeleteProject(ctx context.Context, request DeleteProjectRequestObject) (DeleteProjectResponseObject, error)
 	// Get an overview of a project. (deprecated)
 	// (GET /api/admin/projects/{projectId})
 	GetDeprecatedProjectOverview(ctx context.Context, request GetDeprecatedProjectOverviewRequestObject) (GetDeprecatedProjectOverviewResponseObject, error)
+	// Update project
+	// (PUT /api/admin/projects/{projectId})
+	UpdateProject(ctx context.Context, request UpdateProjectRequestObject) (UpdateProjectResponseObject, error)
 	// Get api tokens for project.
 	// (GET /api/admin/projects/{projectId}/api-tokens)
 	GetProjectApiTokens(ctx context.Context, request GetProjectApiTokensRequestObject) (GetProjectApiTokensResponseObject, error)
@@ -57805,6 +60090,39 @@ func (sh *strictHandler) GetAllEnvironments(ctx *gin.Context) {
 	}
 }
 
+// CreateEnvironment operation middleware
+func (sh *strictHandler) CreateEnvironment(ctx *gin.Context) {
+	var request CreateEnvironmentRequestObject
+
+	var body CreateEnvironmentJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateEnvironment(ctx, request.(CreateEnvironmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateEnvironment")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateEnvironmentResponseObject); ok {
+		if err := validResponse.VisitCreateEnvironmentResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetProjectEnvironments operation middleware
 func (sh *strictHandler) GetProjectEnvironments(ctx *gin.Context, projectId string) {
 	var request GetProjectEnvironmentsRequestObject
@@ -57865,6 +60183,68 @@ func (sh *strictHandler) UpdateSortOrder(ctx *gin.Context) {
 	}
 }
 
+// UpdateEnvironment operation middleware
+func (sh *strictHandler) UpdateEnvironment(ctx *gin.Context, name string) {
+	var request UpdateEnvironmentRequestObject
+
+	request.Name = name
+
+	var body UpdateEnvironmentJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateEnvironment(ctx, request.(UpdateEnvironmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateEnvironment")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UpdateEnvironmentResponseObject); ok {
+		if err := validResponse.VisitUpdateEnvironmentResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RemoveEnvironment operation middleware
+func (sh *strictHandler) RemoveEnvironment(ctx *gin.Context, name string) {
+	var request RemoveEnvironmentRequestObject
+
+	request.Name = name
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveEnvironment(ctx, request.(RemoveEnvironmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveEnvironment")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RemoveEnvironmentResponseObject); ok {
+		if err := validResponse.VisitRemoveEnvironmentResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetEnvironment operation middleware
 func (sh *strictHandler) GetEnvironment(ctx *gin.Context, name string) {
 	var request GetEnvironmentRequestObject
@@ -58877,6 +61257,66 @@ func (sh *strictHandler) GetProjects(ctx *gin.Context) {
 	}
 }
 
+// CreateProject operation middleware
+func (sh *strictHandler) CreateProject(ctx *gin.Context) {
+	var request CreateProjectRequestObject
+
+	var body CreateProjectJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateProject(ctx, request.(CreateProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateProject")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateProjectResponseObject); ok {
+		if err := validResponse.VisitCreateProjectResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteProject operation middleware
+func (sh *strictHandler) DeleteProject(ctx *gin.Context, projectId string) {
+	var request DeleteProjectRequestObject
+
+	request.ProjectId = projectId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProject(ctx, request.(DeleteProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProject")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteProjectResponseObject); ok {
+		if err := validResponse.VisitDeleteProjectResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetDeprecatedProjectOverview operation middleware
 func (sh *strictHandler) GetDeprecatedProjectOverview(ctx *gin.Context, projectId string) {
 	var request GetDeprecatedProjectOverviewRequestObject
@@ -58904,6 +61344,41 @@ func (sh *strictHandler) GetDeprecatedProjectOverview(ctx *gin.Context, projectI
 	}
 }
 
+// UpdateProject operation middleware
+func (sh *strictHandler) UpdateProject(ctx *gin.Context, projectId string) {
+	var request UpdateProjectRequestObject
+
+	request.ProjectId = projectId
+
+	var body UpdateProjectJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateProject(ctx, request.(UpdateProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateProject")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UpdateProjectResponseObject); ok {
+		if err := validResponse.VisitUpdateProjectResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetProjectApiTokens operation middleware
 func (sh *strictHandler) GetProjectApiTokens(ctx *gin.Context, projectId string) {
 	var request GetProjectApiTokensRequestObject